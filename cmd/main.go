@@ -4,14 +4,24 @@ import (
 	"context"
 	"database/sql"
 	"log/slog"
+	"math/rand"
 	"os"
+	"strconv"
+	"time"
+	_ "time/tzdata"
 
+	"github.com/gorilla/mux"
 	_ "github.com/jackc/pgx/v5/stdlib"
 	"github.com/pressly/goose/v3"
 
 	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/delivery"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/delivery/grpcapi"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/events"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/middleware"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/openapi"
 	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/repository"
 	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/router"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/scheduler"
 	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/server"
 	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/usecase"
 )
@@ -47,9 +57,108 @@ func main() {
 	defer pool.Close()
 
 	repo := repository.NewRepository(pool, logger)
-	service := usecase.NewPRService(repo, logger)
-	handler := delivery.NewHandler(service, logger)
+	hub := events.NewHub(repository.NewEventRepository(pool, logger), logger)
+	go hub.Run(context.Background())
 
-	srv := server.NewServer(":8080", router.Router(handler), logger)
+	eventRetention := 7 * 24 * time.Hour
+	if v := os.Getenv("EVENTS_RETENTION"); v != "" {
+		eventRetention, err = time.ParseDuration(v)
+		if err != nil {
+			logger.Error("invalid EVENTS_RETENTION", "err", err)
+			os.Exit(1)
+		}
+	}
+	go hub.RunCleanup(context.Background(), time.Hour, eventRetention)
+
+	// Reviewer selection is seeded from a single source; each selection logs
+	// its own seed. SELECTION_SEED fixes the source to reproduce a run.
+	seed := time.Now().UnixNano()
+	if v := os.Getenv("SELECTION_SEED"); v != "" {
+		seed, err = strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			logger.Error("invalid SELECTION_SEED", "err", err)
+			os.Exit(1)
+		}
+	}
+	seeding := usecase.WithRand(rand.NewSource(seed))
+	// SELECTION_REPLAY_SEED takes the seed logged for one selection and uses
+	// it for every selection, to replay that one.
+	if v := os.Getenv("SELECTION_REPLAY_SEED"); v != "" {
+		seed, err = strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			logger.Error("invalid SELECTION_REPLAY_SEED", "err", err)
+			os.Exit(1)
+		}
+		seeding = usecase.WithReplaySeed(seed)
+		logger.Warn("replaying selection seed for every selection", "seed", seed)
+	} else {
+		logger.Info("selection seed", "seed", seed)
+	}
+
+	service := usecase.NewPRService(repo, hub, logger, seeding)
+	handler := delivery.NewHandler(service, hub, logger)
+
+	slaInterval := time.Minute
+	if v := os.Getenv("SLA_CHECK_INTERVAL"); v != "" {
+		slaInterval, err = time.ParseDuration(v)
+		if err != nil || slaInterval <= 0 {
+			logger.Error("invalid SLA_CHECK_INTERVAL", "value", v)
+			os.Exit(1)
+		}
+	}
+	var notifier scheduler.Notifier = scheduler.NewLogNotifier(logger)
+	if v := os.Getenv("SLA_WEBHOOK_URL"); v != "" {
+		notifier = scheduler.NewWebhookNotifier(v)
+	}
+	sla := scheduler.NewSLAScheduler(service, repository.NewLocker(pool), notifier, slaInterval, logger)
+	go sla.Run(context.Background())
+
+	idempotencyTTL := 24 * time.Hour
+	if v := os.Getenv("IDEMPOTENCY_TTL"); v != "" {
+		idempotencyTTL, err = time.ParseDuration(v)
+		if err != nil {
+			logger.Error("invalid IDEMPOTENCY_TTL", "err", err)
+			os.Exit(1)
+		}
+	}
+	trustProxy := os.Getenv("RATE_LIMIT_TRUST_PROXY") == "true"
+	idempotency := middleware.NewIdempotency(repository.NewIdempotencyRepository(pool, logger), idempotencyTTL, trustProxy, logger)
+	go idempotency.RunCleanup(context.Background(), time.Hour)
+
+	rateLimits := os.Getenv("RATE_LIMITS")
+	if rateLimits == "" {
+		rateLimits = middleware.DefaultRateLimits
+	}
+	rateCfg, err := middleware.ParseRateLimits(rateLimits)
+	if err != nil {
+		logger.Error("invalid RATE_LIMITS", "err", err)
+		os.Exit(1)
+	}
+	rateCfg.TrustForwardedFor = trustProxy
+	limiter := middleware.NewRateLimiter(middleware.NewMemoryStore(), rateCfg, logger)
+
+	mws := []mux.MiddlewareFunc{limiter.Middleware, idempotency.Middleware}
+	if os.Getenv("OPENAPI_VALIDATE") == "true" {
+		validator, err := openapi.NewValidator(logger)
+		if err != nil {
+			logger.Error("failed to init openapi validator", "err", err)
+			os.Exit(1)
+		}
+		mws = append(mws, validator.Middleware)
+		logger.Info("openapi validation enabled")
+	}
+
+	grpcAddr := os.Getenv("GRPC_ADDR")
+	if grpcAddr == "" {
+		grpcAddr = ":9090"
+	}
+	grpcSrv := server.NewGRPCServer(grpcAddr, grpcapi.NewService(service, logger), logger)
+	if err := grpcSrv.Start(); err != nil {
+		logger.Error("failed to start grpc server", "err", err)
+		os.Exit(1)
+	}
+
+	srv := server.NewServer(":8080", router.Router(handler, mws...), logger)
 	srv.Run()
+	grpcSrv.Stop()
 }
@@ -0,0 +1,359 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	d "github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/delivery/dto"
+)
+
+// errNotFound is matched by errors from both backends when the requested
+// team, user or pull request does not exist.
+var errNotFound = errors.New("not found")
+
+type reviewsQuery struct {
+	Status     string
+	Repository string
+	Label      string
+	Limit      int
+	Cursor     string
+}
+
+// backend is implemented over the HTTP API and directly over Postgres.
+type backend interface {
+	AddTeam(ctx context.Context, req d.TeamDTO) (*d.TeamResponse, error)
+	UpdateTeam(ctx context.Context, req d.TeamUpdateDTO) (*d.TeamResponse, error)
+	GetTeam(ctx context.Context, teamName string) (*d.TeamResponse, error)
+	ImportTeams(ctx context.Context, format string, doc []byte, dryRun bool) (*d.TeamImportResponse, error)
+	ExportTeams(ctx context.Context, format, teamName string) ([]byte, error)
+	SetTeamRules(ctx context.Context, req d.TeamRulesDTO) (*d.TeamRulesResponse, error)
+	GetTeamRules(ctx context.Context, teamName string) (*d.TeamRulesResponse, error)
+	SetRepository(ctx context.Context, req d.RepositoryDTO) (*d.RepositoryDTO, error)
+	ListRepositories(ctx context.Context) (*d.RepositoryListResponse, error)
+	GetUser(ctx context.Context, userID string) (*d.UserDetailsResponse, error)
+	SetIsActive(ctx context.Context, req d.UserActiveDTO) (*d.UserResponse, error)
+	CreatePR(ctx context.Context, req d.PRCreateDTO) (*d.PRResponse, error)
+	GetPR(ctx context.Context, prID string) (*d.PRResponse, error)
+	ReadyPR(ctx context.Context, req d.PRReadyDTO) (*d.PRResponse, error)
+	MergePR(ctx context.Context, req d.PRMergeDTO) (*d.PRResponse, error)
+	Reassign(ctx context.Context, req d.PRReassignDTO) (*d.PRResponse, string, error)
+	GetReviews(ctx context.Context, userID string, q reviewsQuery) (*d.UserReviewsResponse, error)
+}
+
+// apiError is an error response of the HTTP API.
+type apiError struct {
+	Status  int
+	Code    string
+	Message string
+	Details []d.FieldError
+}
+
+func (e *apiError) Error() string {
+	msg := fmt.Sprintf("%s: %s", e.Code, e.Message)
+	for _, fe := range e.Details {
+		msg += fmt.Sprintf("\n  %s: %s", fe.Field, fe.Reason)
+	}
+	return msg
+}
+
+func (e *apiError) Unwrap() error {
+	if e.Status == http.StatusNotFound {
+		return errNotFound
+	}
+	return nil
+}
+
+type httpBackend struct {
+	base   string
+	client *http.Client
+}
+
+func newHTTPBackend(addr string, timeout time.Duration) *httpBackend {
+	return &httpBackend{
+		base:   strings.TrimRight(addr, "/"),
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// rawBody is sent as is instead of being encoded as JSON.
+type rawBody struct {
+	contentType string
+	data        []byte
+}
+
+// do sends a request and decodes a successful response into out, or copies
+// it when out is a *[]byte. POST requests carry an Idempotency-Key and are
+// retried on transport errors, so a timed out reassign is not applied twice.
+func (b *httpBackend) do(ctx context.Context, method, path string, query url.Values, body, out any) error {
+	target := b.base + path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+	var payload []byte
+	contentType := "application/json"
+	switch v := body.(type) {
+	case nil:
+	case rawBody:
+		payload, contentType = v.data, v.contentType
+	default:
+		var err error
+		if payload, err = json.Marshal(body); err != nil {
+			return fmt.Errorf("encode request: %w", err)
+		}
+	}
+	key := ""
+	attempts := 1
+	if method == http.MethodPost {
+		key = newIdempotencyKey()
+		attempts = 3
+	}
+
+	var resp *http.Response
+	for i := 0; ; i++ {
+		req, err := http.NewRequestWithContext(ctx, method, target, bytes.NewReader(payload))
+		if err != nil {
+			return fmt.Errorf("build request: %w", err)
+		}
+		if body != nil {
+			req.Header.Set("Content-Type", contentType)
+		}
+		if key != "" {
+			req.Header.Set("Idempotency-Key", key)
+		}
+		resp, err = b.client.Do(req)
+		if err == nil {
+			break
+		}
+		if i+1 >= attempts || ctx.Err() != nil {
+			return fmt.Errorf("%s %s: %w", method, path, err)
+		}
+		time.Sleep(time.Duration(i+1) * 500 * time.Millisecond)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("read response: %w", err)
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		var er struct {
+			Error struct {
+				Code    string         `json:"code"`
+				Message string         `json:"message"`
+				Details []d.FieldError `json:"details"`
+			} `json:"error"`
+		}
+		if err := json.Unmarshal(data, &er); err != nil || er.Error.Code == "" {
+			return &apiError{Status: resp.StatusCode, Code: strconv.Itoa(resp.StatusCode), Message: strings.TrimSpace(string(data))}
+		}
+		return &apiError{Status: resp.StatusCode, Code: er.Error.Code, Message: er.Error.Message, Details: er.Error.Details}
+	}
+	switch v := out.(type) {
+	case nil:
+		return nil
+	case *[]byte:
+		*v = data
+		return nil
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+	return nil
+}
+
+func newIdempotencyKey() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func (b *httpBackend) AddTeam(ctx context.Context, req d.TeamDTO) (*d.TeamResponse, error) {
+	var resp struct {
+		Team d.TeamResponse `json:"team"`
+	}
+	if err := b.do(ctx, http.MethodPost, "/team/add", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Team, nil
+}
+
+func (b *httpBackend) UpdateTeam(ctx context.Context, req d.TeamUpdateDTO) (*d.TeamResponse, error) {
+	var resp struct {
+		Team d.TeamResponse `json:"team"`
+	}
+	if err := b.do(ctx, http.MethodPut, "/team/update", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Team, nil
+}
+
+func (b *httpBackend) GetTeam(ctx context.Context, teamName string) (*d.TeamResponse, error) {
+	var resp d.TeamResponse
+	if err := b.do(ctx, http.MethodGet, "/team/get", url.Values{"team_name": {teamName}}, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (b *httpBackend) ImportTeams(ctx context.Context, format string, doc []byte, dryRun bool) (*d.TeamImportResponse, error) {
+	query := url.Values{"format": {format}, "dry_run": {strconv.FormatBool(dryRun)}}
+	var resp d.TeamImportResponse
+	body := rawBody{contentType: "text/" + format, data: doc}
+	if format == "yaml" {
+		body.contentType = "application/yaml"
+	}
+	if err := b.do(ctx, http.MethodPost, "/team/import", query, body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (b *httpBackend) ExportTeams(ctx context.Context, format, teamName string) ([]byte, error) {
+	query := url.Values{"format": {format}}
+	if teamName != "" {
+		query.Set("team_name", teamName)
+	}
+	var doc []byte
+	if err := b.do(ctx, http.MethodGet, "/team/export", query, nil, &doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+func (b *httpBackend) SetTeamRules(ctx context.Context, req d.TeamRulesDTO) (*d.TeamRulesResponse, error) {
+	var resp d.TeamRulesResponse
+	if err := b.do(ctx, http.MethodPost, "/team/rules", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (b *httpBackend) GetTeamRules(ctx context.Context, teamName string) (*d.TeamRulesResponse, error) {
+	var resp d.TeamRulesResponse
+	if err := b.do(ctx, http.MethodGet, "/team/rules", url.Values{"team_name": {teamName}}, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (b *httpBackend) SetRepository(ctx context.Context, req d.RepositoryDTO) (*d.RepositoryDTO, error) {
+	var resp d.RepositoryDTO
+	if err := b.do(ctx, http.MethodPost, "/repository/set", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (b *httpBackend) ListRepositories(ctx context.Context) (*d.RepositoryListResponse, error) {
+	var resp d.RepositoryListResponse
+	if err := b.do(ctx, http.MethodGet, "/repository/list", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (b *httpBackend) GetUser(ctx context.Context, userID string) (*d.UserDetailsResponse, error) {
+	var resp struct {
+		User d.UserDetailsResponse `json:"user"`
+	}
+	if err := b.do(ctx, http.MethodGet, "/users/get", url.Values{"user_id": {userID}}, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.User, nil
+}
+
+func (b *httpBackend) SetIsActive(ctx context.Context, req d.UserActiveDTO) (*d.UserResponse, error) {
+	var resp struct {
+		User d.UserResponse `json:"user"`
+	}
+	if err := b.do(ctx, http.MethodPost, "/users/setIsActive", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.User, nil
+}
+
+func (b *httpBackend) CreatePR(ctx context.Context, req d.PRCreateDTO) (*d.PRResponse, error) {
+	var resp struct {
+		PR d.PRResponse `json:"pr"`
+	}
+	if err := b.do(ctx, http.MethodPost, "/pullRequest/create", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.PR, nil
+}
+
+func (b *httpBackend) GetPR(ctx context.Context, prID string) (*d.PRResponse, error) {
+	var resp struct {
+		PR d.PRResponse `json:"pr"`
+	}
+	if err := b.do(ctx, http.MethodGet, "/pullRequest/get", url.Values{"pull_request_id": {prID}}, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.PR, nil
+}
+
+func (b *httpBackend) ReadyPR(ctx context.Context, req d.PRReadyDTO) (*d.PRResponse, error) {
+	var resp struct {
+		PR d.PRResponse `json:"pr"`
+	}
+	if err := b.do(ctx, http.MethodPost, "/pullRequest/ready", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.PR, nil
+}
+
+func (b *httpBackend) MergePR(ctx context.Context, req d.PRMergeDTO) (*d.PRResponse, error) {
+	var resp struct {
+		PR d.PRResponse `json:"pr"`
+	}
+	if err := b.do(ctx, http.MethodPost, "/pullRequest/merge", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.PR, nil
+}
+
+func (b *httpBackend) Reassign(ctx context.Context, req d.PRReassignDTO) (*d.PRResponse, string, error) {
+	var resp struct {
+		PR         d.PRResponse `json:"pr"`
+		ReplacedBy string       `json:"replaced_by"`
+	}
+	if err := b.do(ctx, http.MethodPost, "/pullRequest/reassign", nil, req, &resp); err != nil {
+		return nil, "", err
+	}
+	return &resp.PR, resp.ReplacedBy, nil
+}
+
+func (b *httpBackend) GetReviews(ctx context.Context, userID string, q reviewsQuery) (*d.UserReviewsResponse, error) {
+	params := url.Values{"user_id": {userID}}
+	if q.Status != "" {
+		params.Set("status", q.Status)
+	}
+	if q.Repository != "" {
+		params.Set("repository", q.Repository)
+	}
+	if q.Label != "" {
+		params.Set("label", q.Label)
+	}
+	if q.Limit > 0 {
+		params.Set("limit", strconv.Itoa(q.Limit))
+	}
+	if q.Cursor != "" {
+		params.Set("cursor", q.Cursor)
+	}
+	var resp d.UserReviewsResponse
+	if err := b.do(ctx, http.MethodGet, "/users/getReview", params, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
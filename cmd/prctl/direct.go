@@ -0,0 +1,295 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/delivery"
+	d "github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/delivery/dto"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/events"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/repository"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/roster"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/usecase"
+)
+
+// directBackend runs the service logic in-process against Postgres. Events
+// still go through the shared log, so stream clients of the running service
+// see changes made here.
+type directBackend struct {
+	service usecase.PRService
+	close   func()
+}
+
+func newDirectBackend(ctx context.Context, dbConn string, logger *slog.Logger) (*directBackend, error) {
+	pool, err := repository.NewPool(ctx, dbConn, logger)
+	if err != nil {
+		return nil, err
+	}
+	hub := events.NewHub(repository.NewEventRepository(pool, logger), logger)
+	service := usecase.NewPRService(repository.NewRepository(pool, logger), hub, logger)
+	return &directBackend{service: service, close: pool.Close}, nil
+}
+
+// wrap marks missing entities with errNotFound so callers can tell them
+// apart from other failures regardless of the backend.
+func wrap(err error) error {
+	if errors.Is(err, repository.ErrTeamNotFound) ||
+		errors.Is(err, repository.ErrUserNotFound) ||
+		errors.Is(err, repository.ErrPRNotFound) ||
+		errors.Is(err, repository.ErrRepositoryNotFound) ||
+		errors.Is(err, usecase.ErrNotTeamMember) {
+		return fmt.Errorf("%w: %v", errNotFound, err)
+	}
+	return err
+}
+
+func toModelTeam(name string, members []d.MemberDTO) models.Team {
+	team := models.Team{Name: name}
+	for _, m := range members {
+		team.Members = append(team.Members, models.Member{ID: m.UserID, Username: m.Username, IsActive: m.IsActive})
+	}
+	return team
+}
+
+// invalid reports a failed dto check the way the HTTP API does, so both
+// backends reject the same requests with the same details.
+func invalid(err error) error {
+	var verr *d.ValidationError
+	if errors.As(err, &verr) {
+		return &apiError{Status: http.StatusBadRequest, Code: "INVALID_REQUEST", Message: "validation failed", Details: verr.Fields}
+	}
+	return err
+}
+
+func teamDTO(team *models.Team) *d.TeamResponse {
+	resp := delivery.TeamResponse(team)
+	return &resp
+}
+
+func prDTO(pr *models.PullRequest) *d.PRResponse {
+	resp := delivery.PRResponse(pr)
+	return &resp
+}
+
+func (b *directBackend) AddTeam(ctx context.Context, req d.TeamDTO) (*d.TeamResponse, error) {
+	if err := invalid(req.Validate()); err != nil {
+		return nil, err
+	}
+	team, err := b.service.CreateTeam(ctx, toModelTeam(req.TeamName, req.Members))
+	if err != nil {
+		return nil, wrap(err)
+	}
+	return teamDTO(team), nil
+}
+
+func (b *directBackend) UpdateTeam(ctx context.Context, req d.TeamUpdateDTO) (*d.TeamResponse, error) {
+	if err := invalid(req.Validate()); err != nil {
+		return nil, err
+	}
+	team, err := b.service.UpdateTeam(ctx, toModelTeam(req.TeamName, req.Members), models.OpenPRPolicy(req.OpenPRsPolicy))
+	if err != nil {
+		return nil, wrap(err)
+	}
+	return teamDTO(team), nil
+}
+
+func (b *directBackend) GetTeam(ctx context.Context, teamName string) (*d.TeamResponse, error) {
+	team, err := b.service.GetTeam(ctx, teamName)
+	if err != nil {
+		return nil, wrap(err)
+	}
+	return teamDTO(team), nil
+}
+
+func (b *directBackend) ImportTeams(ctx context.Context, format string, doc []byte, dryRun bool) (*d.TeamImportResponse, error) {
+	f, err := roster.ParseFormat(format)
+	if err != nil {
+		return nil, err
+	}
+	teams, err := roster.Decode(bytes.NewReader(doc), f)
+	if err != nil {
+		return nil, err
+	}
+	if err := invalid(delivery.ValidateRoster(teams)); err != nil {
+		return nil, err
+	}
+	res, err := b.service.ImportTeams(ctx, teams, dryRun)
+	if err != nil {
+		return nil, wrap(err)
+	}
+	resp := delivery.TeamImportResponse(res, dryRun)
+	return &resp, nil
+}
+
+func (b *directBackend) ExportTeams(ctx context.Context, format, teamName string) ([]byte, error) {
+	f, err := roster.ParseFormat(format)
+	if err != nil {
+		return nil, err
+	}
+	teams, err := b.service.ExportTeams(ctx, teamName)
+	if err != nil {
+		return nil, wrap(err)
+	}
+	var buf bytes.Buffer
+	if err := roster.Encode(&buf, f, teams); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (b *directBackend) SetTeamRules(ctx context.Context, req d.TeamRulesDTO) (*d.TeamRulesResponse, error) {
+	if err := invalid(req.Validate()); err != nil {
+		return nil, err
+	}
+	rules, err := b.service.SetPairRules(ctx, req.TeamName, delivery.PairRules(req.Rules))
+	if err != nil {
+		return nil, wrap(err)
+	}
+	resp := delivery.TeamRulesResponse(req.TeamName, rules)
+	return &resp, nil
+}
+
+func (b *directBackend) GetTeamRules(ctx context.Context, teamName string) (*d.TeamRulesResponse, error) {
+	rules, err := b.service.GetPairRules(ctx, teamName)
+	if err != nil {
+		return nil, wrap(err)
+	}
+	resp := delivery.TeamRulesResponse(teamName, rules)
+	return &resp, nil
+}
+
+func (b *directBackend) SetRepository(ctx context.Context, req d.RepositoryDTO) (*d.RepositoryDTO, error) {
+	if err := invalid(req.Validate()); err != nil {
+		return nil, err
+	}
+	repo, err := b.service.SetRepository(ctx, models.Repository{Name: req.Repository, TeamName: req.TeamName})
+	if err != nil {
+		return nil, wrap(err)
+	}
+	return &d.RepositoryDTO{Repository: repo.Name, TeamName: repo.TeamName}, nil
+}
+
+func (b *directBackend) ListRepositories(ctx context.Context) (*d.RepositoryListResponse, error) {
+	repos, err := b.service.ListRepositories(ctx)
+	if err != nil {
+		return nil, wrap(err)
+	}
+	resp := &d.RepositoryListResponse{Repositories: []d.RepositoryDTO{}}
+	for _, repo := range repos {
+		resp.Repositories = append(resp.Repositories, d.RepositoryDTO{Repository: repo.Name, TeamName: repo.TeamName})
+	}
+	return resp, nil
+}
+
+func (b *directBackend) GetUser(ctx context.Context, userID string) (*d.UserDetailsResponse, error) {
+	u, err := b.service.GetUser(ctx, userID)
+	if err != nil {
+		return nil, wrap(err)
+	}
+	resp := delivery.UserDetailsResponse(u)
+	return &resp, nil
+}
+
+func (b *directBackend) SetIsActive(ctx context.Context, req d.UserActiveDTO) (*d.UserResponse, error) {
+	if err := invalid(req.Validate()); err != nil {
+		return nil, err
+	}
+	u, err := b.service.SetUserActive(ctx, req.UserID, req.IsActive)
+	if err != nil {
+		return nil, wrap(err)
+	}
+	resp := delivery.UserResponse(u)
+	return &resp, nil
+}
+
+func (b *directBackend) CreatePR(ctx context.Context, req d.PRCreateDTO) (*d.PRResponse, error) {
+	if err := invalid(req.Validate()); err != nil {
+		return nil, err
+	}
+	pr, err := b.service.CreatePR(ctx, models.PullRequest{
+		ID:           req.PullRequestID,
+		Name:         req.PullRequestName,
+		AuthorID:     req.AuthorID,
+		ChangedFiles: req.ChangedFiles,
+		RequiredTags: req.RequiredTags,
+		IsDraft:      req.IsDraft,
+		Repository:   req.Repository,
+		URL:          req.URL,
+		Labels:       req.Labels,
+		LinesAdded:   req.LinesAdded,
+		LinesRemoved: req.LinesRemoved,
+		Description:  req.Description,
+	})
+	if err != nil {
+		return nil, wrap(err)
+	}
+	return prDTO(pr), nil
+}
+
+func (b *directBackend) GetPR(ctx context.Context, prID string) (*d.PRResponse, error) {
+	pr, err := b.service.GetPR(ctx, prID)
+	if err != nil {
+		return nil, wrap(err)
+	}
+	return prDTO(pr), nil
+}
+
+func (b *directBackend) ReadyPR(ctx context.Context, req d.PRReadyDTO) (*d.PRResponse, error) {
+	if err := invalid(req.Validate()); err != nil {
+		return nil, err
+	}
+	pr, err := b.service.ReadyPR(ctx, req.PullRequestID)
+	if err != nil {
+		return nil, wrap(err)
+	}
+	return prDTO(pr), nil
+}
+
+func (b *directBackend) MergePR(ctx context.Context, req d.PRMergeDTO) (*d.PRResponse, error) {
+	if err := invalid(req.Validate()); err != nil {
+		return nil, err
+	}
+	pr, err := b.service.MergePR(ctx, req.PullRequestID)
+	if err != nil {
+		return nil, wrap(err)
+	}
+	return prDTO(pr), nil
+}
+
+func (b *directBackend) Reassign(ctx context.Context, req d.PRReassignDTO) (*d.PRResponse, string, error) {
+	if err := invalid(req.Validate()); err != nil {
+		return nil, "", err
+	}
+	pr, replacedBy, err := b.service.ReassignReviewer(ctx, req.PullRequestID, req.OldUserID)
+	if err != nil {
+		return nil, "", wrap(err)
+	}
+	return prDTO(pr), replacedBy, nil
+}
+
+func (b *directBackend) GetReviews(ctx context.Context, userID string, q reviewsQuery) (*d.UserReviewsResponse, error) {
+	after, err := models.DecodeCursor(q.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	prs, next, err := b.service.GetUserReviews(ctx, userID, models.ReviewFilter{
+		Status:     q.Status,
+		Repository: q.Repository,
+		Label:      q.Label,
+		Limit:      q.Limit,
+		After:      after,
+	})
+	if err != nil {
+		return nil, wrap(err)
+	}
+	resp := &d.UserReviewsResponse{UserID: userID, PullRequests: []d.PRShortResponse{}, NextCursor: next.Encode()}
+	for _, pr := range prs {
+		resp.PullRequests = append(resp.PullRequests, delivery.PRShortResponse(&pr))
+	}
+	return resp, nil
+}
@@ -0,0 +1,468 @@
+// Command prctl is an admin CLI for the PR reviewer assignment service. It
+// talks to the HTTP API, or with --direct runs the service logic against
+// Postgres itself, which helps when the API is down.
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"time"
+
+	d "github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/delivery/dto"
+)
+
+const usage = `usage: prctl [flags] <command> [args]
+
+commands:
+  team add -f FILE                     create a team from a JSON TeamDTO
+  team update [--policy P] -f FILE     replace team membership
+  team import [--dry-run] -f FILE      sync teams from a YAML or CSV roster
+  team export [--format F] [NAME]      print teams as a YAML or CSV roster
+  team get NAME
+  rules set -f FILE                    replace pair rules from a JSON TeamRulesDTO
+  rules get TEAM
+  repo set NAME [TEAM]                 map a repository to its reviewing team
+  repo list
+  user get USER_ID
+  user activate USER_ID...
+  user deactivate USER_ID...
+  pr create --id ID --name NAME --author USER_ID [--files a,b] [--tags go,sql] [--draft]
+            [--repo R] [--url U] [--labels a,b] [--added N] [--removed N] [--description D]
+  pr get PR_ID
+  pr ready PR_ID                       assign reviewers to a draft
+  pr merge PR_ID
+  pr reassign PR_ID OLD_USER_ID
+  reviews [--status OPEN|MERGED] [--repo R] [--label L] [--limit N] [--cursor C] [--all] USER_ID
+
+FILE may be - for stdin; P is refuse (default) or reassign; F is yaml
+(default) or csv. Roster formats are taken from the file extension, or
+--format for stdin.
+
+flags:
+`
+
+type app struct {
+	backend backend
+	out     *printer
+}
+
+func main() {
+	global := flag.NewFlagSet("prctl", flag.ExitOnError)
+	addr := global.String("addr", envOr("PRCTL_ADDR", "http://localhost:8080"), "service base URL")
+	direct := global.Bool("direct", false, "work directly against Postgres instead of the HTTP API")
+	dbConn := global.String("db", os.Getenv("DB_CONN"), "Postgres connection string for --direct")
+	output := global.String("o", "table", "output format: table or json")
+	timeout := global.Duration("timeout", 30*time.Second, "overall command timeout")
+	global.Usage = func() {
+		fmt.Fprint(global.Output(), usage)
+		global.PrintDefaults()
+	}
+	_ = global.Parse(os.Args[1:])
+
+	if *output != "table" && *output != "json" {
+		fatal(errors.New("-o must be table or json"))
+	}
+	if global.NArg() == 0 {
+		global.Usage()
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	ctx, cancel := context.WithTimeout(ctx, *timeout)
+	defer cancel()
+
+	a := &app{out: &printer{w: os.Stdout, json: *output == "json"}}
+	if *direct {
+		if *dbConn == "" {
+			fatal(errors.New("--direct needs --db or DB_CONN"))
+		}
+		logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelWarn}))
+		b, err := newDirectBackend(ctx, *dbConn, logger)
+		if err != nil {
+			fatal(err)
+		}
+		defer b.close()
+		a.backend = b
+	} else {
+		a.backend = newHTTPBackend(*addr, *timeout)
+	}
+
+	if err := a.run(ctx, global.Args()); err != nil {
+		var ue usageError
+		if errors.As(err, &ue) {
+			fmt.Fprintf(os.Stderr, "prctl: %s\n\n", ue)
+			global.Usage()
+			os.Exit(2)
+		}
+		fatal(err)
+	}
+}
+
+type usageError string
+
+func (e usageError) Error() string { return string(e) }
+
+func (a *app) run(ctx context.Context, args []string) error {
+	if len(args) == 1 && args[0] != "reviews" {
+		return usageError("missing subcommand for " + args[0])
+	}
+	switch args[0] {
+	case "team":
+		return a.team(ctx, args[1], args[2:])
+	case "rules":
+		return a.rules(ctx, args[1], args[2:])
+	case "repo":
+		return a.repo(ctx, args[1], args[2:])
+	case "user":
+		return a.user(ctx, args[1], args[2:])
+	case "pr":
+		return a.pr(ctx, args[1], args[2:])
+	case "reviews":
+		return a.reviews(ctx, args[1:])
+	default:
+		return usageError("unknown command " + args[0])
+	}
+}
+
+func (a *app) team(ctx context.Context, cmd string, args []string) error {
+	fs := flag.NewFlagSet("team "+cmd, flag.ContinueOnError)
+	file := fs.String("f", "", "JSON file, - for stdin")
+	policy := fs.String("policy", "", "open PRs policy for removed members: refuse or reassign")
+	format := fs.String("format", "", "roster format: yaml or csv")
+	dryRun := fs.Bool("dry-run", false, "only show what an import would change")
+	if err := fs.Parse(args); err != nil {
+		return usageError(err.Error())
+	}
+
+	switch cmd {
+	case "get":
+		if fs.NArg() != 1 {
+			return usageError("team get needs NAME")
+		}
+		team, err := a.backend.GetTeam(ctx, fs.Arg(0))
+		if err != nil {
+			return err
+		}
+		return a.out.team(team)
+
+	case "add":
+		var req d.TeamDTO
+		if err := readJSON(*file, &req); err != nil {
+			return err
+		}
+		team, err := a.backend.AddTeam(ctx, req)
+		if err != nil {
+			return err
+		}
+		return a.out.team(team)
+
+	case "update":
+		var req d.TeamUpdateDTO
+		if err := readJSON(*file, &req); err != nil {
+			return err
+		}
+		if *policy != "" {
+			req.OpenPRsPolicy = *policy
+		}
+		team, err := a.backend.UpdateTeam(ctx, req)
+		if err != nil {
+			return err
+		}
+		return a.out.team(team)
+
+	case "import":
+		if *file == "" {
+			return usageError("-f FILE required")
+		}
+		f := *format
+		if f == "" {
+			f = strings.TrimPrefix(strings.ToLower(filepath.Ext(*file)), ".")
+		}
+		doc, err := readFile(*file)
+		if err != nil {
+			return err
+		}
+		res, err := a.backend.ImportTeams(ctx, f, doc, *dryRun)
+		if err != nil {
+			return err
+		}
+		return a.out.teamImport(res)
+
+	case "export":
+		f := *format
+		if f == "" {
+			f = "yaml"
+		}
+		doc, err := a.backend.ExportTeams(ctx, f, fs.Arg(0))
+		if err != nil {
+			return err
+		}
+		_, err = os.Stdout.Write(doc)
+		return err
+
+	default:
+		return usageError("unknown team command " + cmd)
+	}
+}
+
+func (a *app) rules(ctx context.Context, cmd string, args []string) error {
+	switch cmd {
+	case "set":
+		fs := flag.NewFlagSet("rules set", flag.ContinueOnError)
+		file := fs.String("f", "", "JSON file, - for stdin")
+		if err := fs.Parse(args); err != nil {
+			return usageError(err.Error())
+		}
+		var req d.TeamRulesDTO
+		if err := readJSON(*file, &req); err != nil {
+			return err
+		}
+		rules, err := a.backend.SetTeamRules(ctx, req)
+		if err != nil {
+			return err
+		}
+		return a.out.rules(rules)
+
+	case "get":
+		if len(args) != 1 {
+			return usageError("rules get needs TEAM")
+		}
+		rules, err := a.backend.GetTeamRules(ctx, args[0])
+		if err != nil {
+			return err
+		}
+		return a.out.rules(rules)
+
+	default:
+		return usageError("unknown rules command " + cmd)
+	}
+}
+
+func (a *app) repo(ctx context.Context, cmd string, args []string) error {
+	switch cmd {
+	case "set":
+		if len(args) < 1 || len(args) > 2 {
+			return usageError("repo set needs NAME and an optional TEAM")
+		}
+		req := d.RepositoryDTO{Repository: args[0]}
+		if len(args) == 2 {
+			req.TeamName = args[1]
+		}
+		repo, err := a.backend.SetRepository(ctx, req)
+		if err != nil {
+			return err
+		}
+		return a.out.repositories(&d.RepositoryListResponse{Repositories: []d.RepositoryDTO{*repo}})
+
+	case "list":
+		repos, err := a.backend.ListRepositories(ctx)
+		if err != nil {
+			return err
+		}
+		return a.out.repositories(repos)
+
+	default:
+		return usageError("unknown repo command " + cmd)
+	}
+}
+
+func (a *app) user(ctx context.Context, cmd string, args []string) error {
+	if len(args) == 0 {
+		return usageError("user " + cmd + " needs USER_ID")
+	}
+	switch cmd {
+	case "get":
+		u, err := a.backend.GetUser(ctx, args[0])
+		if err != nil {
+			return err
+		}
+		return a.out.userDetails(u)
+
+	case "activate", "deactivate":
+		var failed bool
+		for _, id := range args {
+			u, err := a.backend.SetIsActive(ctx, d.UserActiveDTO{UserID: id, IsActive: cmd == "activate"})
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "prctl: %s: %v\n", id, err)
+				failed = true
+				continue
+			}
+			if err := a.out.user(u); err != nil {
+				return err
+			}
+		}
+		if failed {
+			return errors.New("some users were not updated")
+		}
+		return nil
+
+	default:
+		return usageError("unknown user command " + cmd)
+	}
+}
+
+func (a *app) pr(ctx context.Context, cmd string, args []string) error {
+	switch cmd {
+	case "create":
+		fs := flag.NewFlagSet("pr create", flag.ContinueOnError)
+		id := fs.String("id", "", "pull request id")
+		name := fs.String("name", "", "pull request name")
+		author := fs.String("author", "", "author user id")
+		files := fs.String("files", "", "comma separated changed file paths")
+		tags := fs.String("tags", "", "comma separated required tags")
+		draft := fs.Bool("draft", false, "create a draft without reviewers")
+		repo := fs.String("repo", "", "repository the pull request belongs to")
+		link := fs.String("url", "", "link to the pull request")
+		labels := fs.String("labels", "", "comma separated labels")
+		added := fs.Int("added", 0, "lines added")
+		removed := fs.Int("removed", 0, "lines removed")
+		description := fs.String("description", "", "pull request description")
+		if err := fs.Parse(args); err != nil {
+			return usageError(err.Error())
+		}
+		if *id == "" || *name == "" || *author == "" {
+			return usageError("pr create needs --id, --name and --author")
+		}
+		pr, err := a.backend.CreatePR(ctx, d.PRCreateDTO{
+			PullRequestID:   *id,
+			PullRequestName: *name,
+			AuthorID:        *author,
+			ChangedFiles:    splitList(*files),
+			RequiredTags:    splitList(*tags),
+			IsDraft:         *draft,
+			Repository:      *repo,
+			URL:             *link,
+			Labels:          splitList(*labels),
+			LinesAdded:      *added,
+			LinesRemoved:    *removed,
+			Description:     *description,
+		})
+		if err != nil {
+			return err
+		}
+		return a.out.pr(pr, "")
+
+	case "get", "ready", "merge":
+		if len(args) != 1 {
+			return usageError("pr " + cmd + " needs PR_ID")
+		}
+		var (
+			pr  *d.PRResponse
+			err error
+		)
+		switch cmd {
+		case "get":
+			pr, err = a.backend.GetPR(ctx, args[0])
+		case "ready":
+			pr, err = a.backend.ReadyPR(ctx, d.PRReadyDTO{PullRequestID: args[0]})
+		default:
+			pr, err = a.backend.MergePR(ctx, d.PRMergeDTO{PullRequestID: args[0]})
+		}
+		if err != nil {
+			return err
+		}
+		return a.out.pr(pr, "")
+
+	case "reassign":
+		if len(args) != 2 {
+			return usageError("pr reassign needs PR_ID and OLD_USER_ID")
+		}
+		pr, replacedBy, err := a.backend.Reassign(ctx, d.PRReassignDTO{PullRequestID: args[0], OldUserID: args[1]})
+		if err != nil {
+			return err
+		}
+		return a.out.pr(pr, replacedBy)
+
+	default:
+		return usageError("unknown pr command " + cmd)
+	}
+}
+
+func (a *app) reviews(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("reviews", flag.ContinueOnError)
+	var q reviewsQuery
+	fs.StringVar(&q.Status, "status", "", "OPEN or MERGED")
+	fs.StringVar(&q.Repository, "repo", "", "only pull requests of this repository")
+	fs.StringVar(&q.Label, "label", "", "only pull requests with this label")
+	fs.IntVar(&q.Limit, "limit", 0, "page size")
+	fs.StringVar(&q.Cursor, "cursor", "", "continue from a previous page")
+	all := fs.Bool("all", false, "follow cursors and print every page")
+	if err := fs.Parse(args); err != nil {
+		return usageError(err.Error())
+	}
+	if fs.NArg() != 1 {
+		return usageError("reviews needs USER_ID")
+	}
+	q.Status = strings.ToUpper(q.Status)
+
+	resp, err := a.backend.GetReviews(ctx, fs.Arg(0), q)
+	if err != nil {
+		return err
+	}
+	for *all && resp.NextCursor != "" {
+		q.Cursor = resp.NextCursor
+		page, err := a.backend.GetReviews(ctx, fs.Arg(0), q)
+		if err != nil {
+			return err
+		}
+		resp.PullRequests = append(resp.PullRequests, page.PullRequests...)
+		resp.NextCursor = page.NextCursor
+	}
+	return a.out.reviews(resp)
+}
+
+func readFile(path string) ([]byte, error) {
+	if path == "-" {
+		return io.ReadAll(os.Stdin)
+	}
+	return os.ReadFile(path)
+}
+
+func readJSON(path string, v any) error {
+	if path == "" {
+		return usageError("-f FILE required")
+	}
+	data, err := readFile(path)
+	if err != nil {
+		return err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return fmt.Errorf("parse %s: %w", path, err)
+	}
+	return nil
+}
+
+func splitList(s string) []string {
+	var out []string
+	for _, part := range strings.Split(s, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			out = append(out, part)
+		}
+	}
+	return out
+}
+
+func envOr(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
+
+func fatal(err error) {
+	fmt.Fprintf(os.Stderr, "prctl: %v\n", err)
+	os.Exit(1)
+}
@@ -0,0 +1,186 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	d "github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/delivery/dto"
+)
+
+type printer struct {
+	w    io.Writer
+	json bool
+}
+
+func (p *printer) emit(v any, table func(tw *tabwriter.Writer)) error {
+	if p.json {
+		enc := json.NewEncoder(p.w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	}
+	tw := tabwriter.NewWriter(p.w, 0, 4, 2, ' ', 0)
+	table(tw)
+	return tw.Flush()
+}
+
+func (p *printer) team(t *d.TeamResponse) error {
+	return p.emit(t, func(tw *tabwriter.Writer) {
+		fmt.Fprintf(tw, "TEAM: %s\n\n", t.TeamName)
+		fmt.Fprintln(tw, "USER_ID\tUSERNAME\tACTIVE")
+		for _, m := range t.Members {
+			fmt.Fprintf(tw, "%s\t%s\t%t\n", m.UserID, m.Username, m.IsActive)
+		}
+	})
+}
+
+func (p *printer) teamImport(r *d.TeamImportResponse) error {
+	return p.emit(r, func(tw *tabwriter.Writer) {
+		fmt.Fprintln(tw, "CHANGE\tUSER_ID\tUSERNAME\tTEAM\tACTIVE\tFROM_TEAM")
+		rows := func(change string, users []d.UserResponse) {
+			for _, u := range users {
+				fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%t\t-\n", change, u.UserID, u.Username, u.TeamName, u.IsActive)
+			}
+		}
+		rows("added", r.Added)
+		rows("updated", r.Updated)
+		for _, m := range r.Moved {
+			fmt.Fprintf(tw, "moved\t%s\t%s\t%s\t%t\t%s\n", m.UserID, m.Username, m.TeamName, m.IsActive, orDash(m.FromTeam))
+		}
+		rows("deactivated", r.Deactivated)
+		fmt.Fprintln(tw)
+		if len(r.CreatedTeams) > 0 {
+			fmt.Fprintf(tw, "new teams: %s\n", strings.Join(r.CreatedTeams, ", "))
+		}
+		state := "applied"
+		if !r.Applied {
+			state = "not applied"
+		}
+		if r.DryRun {
+			state += " (dry run)"
+		}
+		fmt.Fprintf(tw, "%d unchanged, %s\n", r.Unchanged, state)
+	})
+}
+
+func (p *printer) rules(r *d.TeamRulesResponse) error {
+	return p.emit(r, func(tw *tabwriter.Writer) {
+		fmt.Fprintf(tw, "TEAM: %s\n\n", r.TeamName)
+		fmt.Fprintln(tw, "AUTHOR\tTYPE\tREVIEWER")
+		for _, rule := range r.Rules {
+			fmt.Fprintf(tw, "%s\t%s\t%s\n", rule.AuthorID, rule.Type, rule.ReviewerID)
+		}
+	})
+}
+
+func (p *printer) repositories(r *d.RepositoryListResponse) error {
+	return p.emit(r, func(tw *tabwriter.Writer) {
+		fmt.Fprintln(tw, "REPOSITORY\tTEAM")
+		for _, repo := range r.Repositories {
+			fmt.Fprintf(tw, "%s\t%s\n", repo.Repository, orDash(repo.TeamName))
+		}
+	})
+}
+
+func (p *printer) user(u *d.UserResponse) error {
+	return p.emit(u, func(tw *tabwriter.Writer) {
+		fmt.Fprintf(tw, "USER_ID:\t%s\n", u.UserID)
+		fmt.Fprintf(tw, "USERNAME:\t%s\n", u.Username)
+		fmt.Fprintf(tw, "TEAM:\t%s\n", orDash(u.TeamName))
+		fmt.Fprintf(tw, "ACTIVE:\t%t\n", u.IsActive)
+	})
+}
+
+func (p *printer) userDetails(u *d.UserDetailsResponse) error {
+	return p.emit(u, func(tw *tabwriter.Writer) {
+		fmt.Fprintf(tw, "USER_ID:\t%s\n", u.UserID)
+		fmt.Fprintf(tw, "USERNAME:\t%s\n", u.Username)
+		fmt.Fprintf(tw, "TEAM:\t%s\n", orDash(u.TeamName))
+		fmt.Fprintf(tw, "ACTIVE:\t%t\n", u.IsActive)
+		fmt.Fprintf(tw, "AVAILABLE:\t%t\n", u.IsAvailable)
+		fmt.Fprintf(tw, "TAGS:\t%s\n", orDash(strings.Join(u.Tags, ", ")))
+		fmt.Fprintf(tw, "OPEN_REVIEWS:\t%d\n", u.OpenReviewCount)
+		fmt.Fprintf(tw, "AUTHORED_OPEN:\t%s\n", orDash(strings.Join(u.AuthoredOpenPRs, ", ")))
+	})
+}
+
+func (p *printer) pr(pr *d.PRResponse, replacedBy string) error {
+	var v any = pr
+	if replacedBy != "" {
+		v = map[string]any{"pr": pr, "replaced_by": replacedBy}
+	}
+	return p.emit(v, func(tw *tabwriter.Writer) {
+		fmt.Fprintf(tw, "PR_ID:\t%s\n", pr.PullRequestID)
+		fmt.Fprintf(tw, "NAME:\t%s\n", pr.PullRequestName)
+		fmt.Fprintf(tw, "AUTHOR:\t%s\n", pr.AuthorID)
+		if pr.Repository != "" {
+			fmt.Fprintf(tw, "REPOSITORY:\t%s\n", pr.Repository)
+		}
+		if pr.URL != "" {
+			fmt.Fprintf(tw, "URL:\t%s\n", pr.URL)
+		}
+		if len(pr.Labels) > 0 {
+			fmt.Fprintf(tw, "LABELS:\t%s\n", strings.Join(pr.Labels, ", "))
+		}
+		fmt.Fprintf(tw, "SIZE:\t+%d -%d\n", pr.LinesAdded, pr.LinesRemoved)
+		status := pr.Status
+		if pr.IsDraft {
+			status += " (draft)"
+		}
+		fmt.Fprintf(tw, "STATUS:\t%s\n", status)
+		fmt.Fprintf(tw, "REVIEWERS:\t%s\n", orDash(strings.Join(pr.AssignedReviewers, ", ")))
+		if len(pr.UncoveredTags) > 0 {
+			fmt.Fprintf(tw, "UNCOVERED_TAGS:\t%s\n", strings.Join(pr.UncoveredTags, ", "))
+		}
+		if len(pr.PolicyViolations) > 0 {
+			fmt.Fprintf(tw, "POLICY_VIOLATIONS:\t%s\n", strings.Join(pr.PolicyViolations, ", "))
+		}
+		fmt.Fprintf(tw, "CREATED:\t%s\n", formatTime(pr.CreatedAt))
+		fmt.Fprintf(tw, "MERGED:\t%s\n", formatTime(pr.MergedAt))
+		if replacedBy != "" {
+			fmt.Fprintf(tw, "REPLACED_BY:\t%s\n", replacedBy)
+		}
+		if len(pr.Explanations) > 0 {
+			fmt.Fprintln(tw)
+			fmt.Fprintln(tw, "REVIEWER\tSTRATEGY\tPOOL\tSCORE\tIN_HOURS\tEXCLUDED")
+			for _, e := range pr.Explanations {
+				var excluded []string
+				for _, x := range e.Excluded {
+					excluded = append(excluded, x.UserID+" ("+x.Reason+")")
+				}
+				fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%t\t%s\n",
+					e.ReviewerID, e.Strategy, e.PoolSize, e.Score, e.InWorkingHours, orDash(strings.Join(excluded, ", ")))
+			}
+		}
+	})
+}
+
+func (p *printer) reviews(r *d.UserReviewsResponse) error {
+	return p.emit(r, func(tw *tabwriter.Writer) {
+		fmt.Fprintln(tw, "PR_ID\tNAME\tAUTHOR\tREPOSITORY\tSTATUS\tCREATED")
+		for _, pr := range r.PullRequests {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n",
+				pr.PullRequestID, pr.PullRequestName, pr.AuthorID, orDash(pr.Repository), pr.Status, formatTime(pr.CreatedAt))
+		}
+		if r.NextCursor != "" {
+			fmt.Fprintf(tw, "\nmore results: --cursor %s\n", r.NextCursor)
+		}
+	})
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+	return t.Local().Format(time.DateTime)
+}
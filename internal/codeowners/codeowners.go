@@ -0,0 +1,177 @@
+package codeowners
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+)
+
+type Rule struct {
+	Pattern string
+	Owners  []string
+	Line    int
+
+	re      *regexp.Regexp
+	dirOnly bool
+	leaf    bool
+}
+
+type Ruleset struct {
+	Rules []Rule
+}
+
+type ParseError struct {
+	Line int
+	Msg  string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Msg)
+}
+
+// Parse reads a CODEOWNERS-style document. Each non-empty line holds a path
+// pattern followed by zero or more owners; owners may be prefixed with "@".
+// Everything after an unescaped "#" is a comment.
+func Parse(r io.Reader) (*Ruleset, error) {
+	rs := &Ruleset{}
+	sc := bufio.NewScanner(r)
+	line := 0
+	for sc.Scan() {
+		line++
+		fields := strings.Fields(stripComment(sc.Text()))
+		if len(fields) == 0 {
+			continue
+		}
+
+		pattern := strings.ReplaceAll(fields[0], `\#`, "#")
+		rule, err := compile(pattern)
+		if err != nil {
+			return nil, &ParseError{Line: line, Msg: err.Error()}
+		}
+		rule.Line = line
+		for _, o := range fields[1:] {
+			o = strings.TrimPrefix(o, "@")
+			if o == "" {
+				return nil, &ParseError{Line: line, Msg: "empty owner"}
+			}
+			rule.Owners = append(rule.Owners, o)
+		}
+		rs.Rules = append(rs.Rules, rule)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("read codeowners: %w", err)
+	}
+	return rs, nil
+}
+
+// Match returns the owners of the last rule matching filePath, or nil when no
+// rule matches. A matching rule without owners also yields nil.
+func (rs *Ruleset) Match(filePath string) []string {
+	p := normalize(filePath)
+	if p == "" {
+		return nil
+	}
+	for i := len(rs.Rules) - 1; i >= 0; i-- {
+		if rs.Rules[i].matches(p) {
+			return rs.Rules[i].Owners
+		}
+	}
+	return nil
+}
+
+func stripComment(s string) string {
+	for i := 0; i < len(s); i++ {
+		if s[i] == '#' && (i == 0 || s[i-1] != '\\') {
+			return s[:i]
+		}
+	}
+	return s
+}
+
+func normalize(p string) string {
+	p = strings.TrimSpace(p)
+	if p == "" {
+		return ""
+	}
+	p = path.Clean("/" + p)
+	return strings.TrimPrefix(p, "/")
+}
+
+// compile turns a gitignore-style pattern into a rule. A pattern containing a
+// slash anywhere but at the end is anchored to the repository root, otherwise
+// it matches at any depth. A trailing slash restricts the pattern to
+// directories; a pattern that names a directory covers everything below it.
+func compile(pattern string) (Rule, error) {
+	if strings.HasPrefix(pattern, "!") {
+		return Rule{}, fmt.Errorf("negation is not supported: %q", pattern)
+	}
+	if strings.ContainsAny(pattern, "[]") {
+		return Rule{}, fmt.Errorf("character ranges are not supported: %q", pattern)
+	}
+
+	rule := Rule{Pattern: pattern}
+	p := pattern
+	if strings.HasSuffix(p, "/") {
+		rule.dirOnly = true
+		p = strings.TrimRight(p, "/")
+	}
+	anchored := strings.Contains(p, "/")
+	p = strings.TrimLeft(p, "/")
+	if p == "" {
+		return Rule{}, fmt.Errorf("empty pattern: %q", pattern)
+	}
+	// "docs/*" owns direct children only, not nested files (GitHub semantics).
+	rule.leaf = strings.HasSuffix(p, "/*")
+
+	var b strings.Builder
+	b.WriteString("^")
+	if !anchored && !strings.HasPrefix(p, "**") {
+		b.WriteString("(?:.*/)?")
+	}
+	for i := 0; i < len(p); i++ {
+		switch c := p[i]; c {
+		case '*':
+			if i+1 < len(p) && p[i+1] == '*' {
+				i++
+				if i+1 < len(p) && p[i+1] == '/' {
+					i++
+					b.WriteString("(?:.*/)?")
+				} else {
+					b.WriteString(".*")
+				}
+			} else {
+				b.WriteString("[^/]*")
+			}
+		case '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	b.WriteString("$")
+
+	re, err := regexp.Compile(b.String())
+	if err != nil {
+		return Rule{}, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+	}
+	rule.re = re
+	return rule, nil
+}
+
+func (r Rule) matches(p string) bool {
+	if !r.dirOnly && r.re.MatchString(p) {
+		return true
+	}
+	if r.leaf {
+		return false
+	}
+	for dir := path.Dir(p); dir != "."; dir = path.Dir(dir) {
+		if r.re.MatchString(dir) {
+			return true
+		}
+	}
+	return false
+}
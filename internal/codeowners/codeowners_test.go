@@ -0,0 +1,155 @@
+package codeowners
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func mustParse(t *testing.T, doc string) *Ruleset {
+	t.Helper()
+	rs, err := Parse(strings.NewReader(doc))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	return rs
+}
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		path    string
+		want    bool
+	}{
+		{"anchored file at root", "/README.md", "README.md", true},
+		{"anchored file not nested", "/README.md", "docs/README.md", false},
+		{"unanchored file at any depth", "README.md", "docs/README.md", true},
+		{"inner slash anchors", "src/api", "src/api/handler.go", true},
+		{"inner slash anchors not nested", "src/api", "cmd/src/api/handler.go", false},
+		{"extension at root", "*.go", "main.go", true},
+		{"extension nested", "*.go", "internal/usecase/usecase.go", true},
+		{"extension mismatch", "*.go", "go.mod", false},
+		{"question mark single char", "/v?.txt", "v1.txt", true},
+		{"question mark not slash", "/v?.txt", "v/.txt", false},
+		{"leading double star any depth", "**/logs", "a/b/logs/today.log", true},
+		{"leading double star root", "**/logs", "logs", true},
+		{"inner double star zero dirs", "docs/**/*.md", "docs/index.md", true},
+		{"inner double star many dirs", "docs/**/*.md", "docs/a/b/index.md", true},
+		{"inner double star anchored", "docs/**/*.md", "site/docs/index.md", false},
+		{"trailing double star", "/vendor/**", "vendor/x/y.go", true},
+		{"dir only covers contents", "/build/", "build/out/app", true},
+		{"dir only skips file of same name", "/build/", "build", false},
+		{"unanchored dir only nested", "logs/", "svc/logs/today.log", true},
+		{"unanchored dir only file", "logs/", "svc/logs", false},
+		{"directory name covers contents", "/internal", "internal/models/models.go", true},
+		{"star direct child", "docs/*", "docs/guide.md", true},
+		{"star not nested child", "docs/*", "docs/api/guide.md", false},
+		{"star anchored", "docs/*", "site/docs/guide.md", false},
+		{"path normalized", "/src/main.go", "./src/../src/main.go", true},
+		{"leading slash in path", "/src/main.go", "/src/main.go", true},
+		{"empty path", "*", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rs := mustParse(t, tt.pattern+" @owner")
+			got := rs.Match(tt.path) != nil
+			if got != tt.want {
+				t.Errorf("pattern %q path %q: matched %v, want %v", tt.pattern, tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLastMatchWins(t *testing.T) {
+	rs := mustParse(t, `
+* @everyone
+*.go @gophers
+/internal/ @core
+/internal/openapi/*.json @docs @api
+`)
+	tests := []struct {
+		path string
+		want []string
+	}{
+		{"README.md", []string{"everyone"}},
+		{"cmd/main.go", []string{"gophers"}},
+		{"internal/usecase/usecase.go", []string{"core"}},
+		{"internal/openapi/openapi.json", []string{"docs", "api"}},
+		{"internal/openapi/spec.go", []string{"core"}},
+	}
+	for _, tt := range tests {
+		if got := rs.Match(tt.path); !slices.Equal(got, tt.want) {
+			t.Errorf("Match(%q) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestRuleWithoutOwnersUnowns(t *testing.T) {
+	rs := mustParse(t, "* @everyone\n/generated/\n")
+	if got := rs.Match("generated/api.go"); got != nil {
+		t.Errorf("Match = %v, want nil", got)
+	}
+	if got := rs.Match("api.go"); !slices.Equal(got, []string{"everyone"}) {
+		t.Errorf("Match = %v, want [everyone]", got)
+	}
+}
+
+func TestNoRuleMatches(t *testing.T) {
+	rs := mustParse(t, "/docs/ @docs\n")
+	if got := rs.Match("src/main.go"); got != nil {
+		t.Errorf("Match = %v, want nil", got)
+	}
+}
+
+func TestCommentsAndBlankLines(t *testing.T) {
+	rs := mustParse(t, "# owners of the service\n\n   \n\t\n*.go @gophers # go code\n  # indented comment\n\\#notes.txt @scribe\n")
+	if len(rs.Rules) != 2 {
+		t.Fatalf("got %d rules, want 2", len(rs.Rules))
+	}
+	first := rs.Rules[0]
+	if first.Pattern != "*.go" || first.Line != 5 || !slices.Equal(first.Owners, []string{"gophers"}) {
+		t.Errorf("first rule = %q line %d owners %v", first.Pattern, first.Line, first.Owners)
+	}
+	second := rs.Rules[1]
+	if second.Pattern != "#notes.txt" || second.Line != 7 {
+		t.Errorf("second rule = %q line %d, want escaped #notes.txt on line 7", second.Pattern, second.Line)
+	}
+	if got := rs.Match("#notes.txt"); !slices.Equal(got, []string{"scribe"}) {
+		t.Errorf("Match(#notes.txt) = %v, want [scribe]", got)
+	}
+}
+
+func TestOwnersWithoutAt(t *testing.T) {
+	rs := mustParse(t, "*.go @org/team alice bob@example.com\n")
+	want := []string{"org/team", "alice", "bob@example.com"}
+	if got := rs.Match("main.go"); !slices.Equal(got, want) {
+		t.Errorf("Match = %v, want %v", got, want)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		line int
+	}{
+		{"negation", "*.go @a\n!vendor/ @b\n", 2},
+		{"character range", "\n\n*.[ch] @c\n", 3},
+		{"empty owner", "*.go @\n", 1},
+		{"empty pattern", "/ @root\n", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.doc))
+			var pe *ParseError
+			if !errors.As(err, &pe) {
+				t.Fatalf("err = %v, want *ParseError", err)
+			}
+			if pe.Line != tt.line {
+				t.Errorf("line = %d, want %d", pe.Line, tt.line)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package delivery
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/codeowners"
+	d "github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/delivery/dto"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/repository"
+)
+
+func (h *Handler) SetTeamCodeowners(w http.ResponseWriter, r *http.Request) {
+	var req d.TeamCodeownersDTO
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	rs, err := h.service.SetTeamCodeowners(r.Context(), req.TeamName, req.Content)
+	if err != nil {
+		var perr *codeowners.ParseError
+		switch {
+		case errors.As(err, &perr):
+			h.sendError(w, http.StatusBadRequest, "INVALID_CODEOWNERS", perr.Error())
+		case errors.Is(err, repository.ErrTeamNotFound):
+			h.sendError(w, http.StatusNotFound, "NOT_FOUND", "team not found")
+		default:
+			h.sendUnexpected(w, "set codeowners failed", err)
+		}
+		return
+	}
+
+	resp := d.CodeownersResponse{TeamName: req.TeamName, Rules: []d.CodeownersRuleResponse{}}
+	for _, rule := range rs.Rules {
+		owners := rule.Owners
+		if owners == nil {
+			owners = []string{}
+		}
+		resp.Rules = append(resp.Rules, d.CodeownersRuleResponse{
+			Line:    rule.Line,
+			Pattern: rule.Pattern,
+			Owners:  owners,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(resp)
+}
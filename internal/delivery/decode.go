@@ -0,0 +1,89 @@
+package delivery
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	d "github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/delivery/dto"
+)
+
+const maxBodyBytes = 1 << 20
+
+type validatable interface {
+	Validate() error
+}
+
+// decode reads a JSON body into dst and validates it. Oversized bodies,
+// unknown fields and trailing data are rejected. On failure the error
+// response is already written and false is returned.
+func (h *Handler) decode(w http.ResponseWriter, r *http.Request, dst any) bool {
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+	dec.DisallowUnknownFields()
+
+	if err := dec.Decode(dst); err != nil {
+		h.sendDecodeError(w, err)
+		return false
+	}
+	if dec.More() {
+		h.sendError(w, http.StatusBadRequest, "INVALID_REQUEST", "unexpected data after json body")
+		return false
+	}
+
+	if v, ok := dst.(validatable); ok {
+		if err := v.Validate(); err != nil {
+			var verr *d.ValidationError
+			if errors.As(err, &verr) {
+				writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "validation failed", verr.Fields)
+			} else {
+				h.sendError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+			}
+			return false
+		}
+	}
+	return true
+}
+
+func (h *Handler) sendDecodeError(w http.ResponseWriter, err error) {
+	var (
+		tooLarge *http.MaxBytesError
+		typeErr  *json.UnmarshalTypeError
+	)
+	field, unknown := unknownField(err)
+	switch {
+	case errors.As(err, &tooLarge):
+		h.sendError(w, http.StatusRequestEntityTooLarge, "INVALID_REQUEST", "request body too large")
+	case errors.As(err, &typeErr):
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "validation failed",
+			[]d.FieldError{{Field: typeErr.Field, Reason: "must be " + typeErr.Type.String()}})
+	case unknown:
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "validation failed",
+			[]d.FieldError{{Field: field, Reason: "unknown field"}})
+	case errors.Is(err, io.EOF):
+		h.sendError(w, http.StatusBadRequest, "INVALID_REQUEST", "empty body")
+	default:
+		h.sendError(w, http.StatusBadRequest, "INVALID_REQUEST", "invalid json")
+	}
+}
+
+// unknownFieldPrefix starts the error encoding/json returns for a field
+// rejected by DisallowUnknownFields. The package has no error type for it
+// (golang/go#29035), so the message text is all there is to match;
+// TestUnknownField fails if a Go release changes it.
+const unknownFieldPrefix = "json: unknown field "
+
+// unknownField returns the field named by an unknown-field decode error.
+func unknownField(err error) (string, bool) {
+	msg := err.Error()
+	if !strings.HasPrefix(msg, unknownFieldPrefix) {
+		return "", false
+	}
+	field, uerr := strconv.Unquote(strings.TrimPrefix(msg, unknownFieldPrefix))
+	if uerr != nil {
+		return "", false
+	}
+	return field, true
+}
@@ -0,0 +1,40 @@
+package delivery
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestUnknownField(t *testing.T) {
+	tests := []struct {
+		body  string
+		field string
+		ok    bool
+	}{
+		{`{"user_id":"u1","extra":1}`, "extra", true},
+		{`{"user_id":"u1","we\"ird":1}`, `we"ird`, true},
+		{`{"user_id":1}`, "", false},
+		{`{"user_id":`, "", false},
+	}
+	for _, tt := range tests {
+		var dst struct {
+			UserID string `json:"user_id"`
+		}
+		dec := json.NewDecoder(strings.NewReader(tt.body))
+		dec.DisallowUnknownFields()
+		err := dec.Decode(&dst)
+		if err == nil {
+			t.Fatalf("%s: decoded without error", tt.body)
+		}
+		field, ok := unknownField(err)
+		if field != tt.field || ok != tt.ok {
+			t.Errorf("%s: unknownField(%q) = %q, %v, want %q, %v", tt.body, err, field, ok, tt.field, tt.ok)
+		}
+	}
+
+	if _, ok := unknownField(errors.New("something else")); ok {
+		t.Error("matched an unrelated error")
+	}
+}
@@ -13,34 +13,168 @@ type MemberDTO struct {
 	IsActive bool   `json:"is_active"`
 }
 
+type TeamUpdateDTO struct {
+	TeamName      string      `json:"team_name"`
+	Members       []MemberDTO `json:"members"`
+	OpenPRsPolicy string      `json:"open_prs_policy,omitempty"`
+}
+
+type TeamMemberRemoveDTO struct {
+	TeamName      string `json:"team_name"`
+	UserID        string `json:"user_id"`
+	OpenPRsPolicy string `json:"open_prs_policy,omitempty"`
+}
+
+type TeamCodeownersDTO struct {
+	TeamName string `json:"team_name"`
+	Content  string `json:"content"`
+}
+
+type PairRuleDTO struct {
+	Type       string `json:"type"`
+	ReviewerID string `json:"reviewer_id"`
+	AuthorID   string `json:"author_id"`
+}
+
+// TeamRulesDTO replaces all pair rules of a team; an empty list removes them.
+type TeamRulesDTO struct {
+	TeamName string        `json:"team_name"`
+	Rules    []PairRuleDTO `json:"rules"`
+}
+
+type TeamMentorshipDTO struct {
+	TeamName string `json:"team_name"`
+	Enabled  bool   `json:"enabled"`
+}
+
+// TeamLargePRsDTO sets the changed-line count from which pull requests get
+// an extra reviewer; zero turns this off.
+type TeamLargePRsDTO struct {
+	TeamName string `json:"team_name"`
+	MinLines int    `json:"min_lines"`
+}
+
+// TeamSLADTO takes durations in Go syntax, e.g. "24h" or "1h30m". An empty
+// reassign_after turns automatic reassignment off.
+type TeamSLADTO struct {
+	TeamName      string `json:"team_name"`
+	ReviewSLA     string `json:"review_sla"`
+	ReassignAfter string `json:"reassign_after,omitempty"`
+}
+
+// RepositoryDTO maps a repository to the team reviewing its pull requests;
+// without a team the author's team reviews them.
+type RepositoryDTO struct {
+	Repository string `json:"repository"`
+	TeamName   string `json:"team_name,omitempty"`
+}
+
 type UserActiveDTO struct {
 	UserID   string `json:"user_id"`
 	IsActive bool   `json:"is_active"`
 }
 
+type UserMoveDTO struct {
+	UserID       string `json:"user_id"`
+	TeamName     string `json:"team_name"`
+	ReviewPolicy string `json:"review_policy,omitempty"`
+}
+
+type UserTagsDTO struct {
+	UserID string   `json:"user_id"`
+	Tags   []string `json:"tags"`
+}
+
+type UserSeniorityDTO struct {
+	UserID    string `json:"user_id"`
+	Seniority string `json:"seniority"`
+}
+
+// ScheduleDTO is a working-hours schedule. Start and end are local "HH:MM"
+// times; days are three-letter weekday names and default to mon-fri.
+type ScheduleDTO struct {
+	Timezone string   `json:"timezone"`
+	Start    string   `json:"start"`
+	End      string   `json:"end"`
+	Days     []string `json:"days,omitempty"`
+}
+
+// UserScheduleDTO sets a user's working hours; a null schedule removes them.
+type UserScheduleDTO struct {
+	UserID   string       `json:"user_id"`
+	Schedule *ScheduleDTO `json:"schedule"`
+}
+
 type PRCreateDTO struct {
-	PullRequestID   string `json:"pull_request_id"`
-	PullRequestName string `json:"pull_request_name"`
-	AuthorID        string `json:"author_id"`
+	PullRequestID   string   `json:"pull_request_id"`
+	PullRequestName string   `json:"pull_request_name"`
+	AuthorID        string   `json:"author_id"`
+	ChangedFiles    []string `json:"changed_files,omitempty"`
+	RequiredTags    []string `json:"required_tags,omitempty"`
+	IsDraft         bool     `json:"is_draft,omitempty"`
+	Repository      string   `json:"repository,omitempty"`
+	URL             string   `json:"url,omitempty"`
+	Labels          []string `json:"labels,omitempty"`
+	LinesAdded      int      `json:"lines_added,omitempty"`
+	LinesRemoved    int      `json:"lines_removed,omitempty"`
+	Description     string   `json:"description,omitempty"`
 }
 
 type PRMergeDTO struct {
 	PullRequestID string `json:"pull_request_id"`
 }
 
+type PRReadyDTO struct {
+	PullRequestID string `json:"pull_request_id"`
+}
+
 type PRReassignDTO struct {
 	PullRequestID string `json:"pull_request_id"`
 	OldUserID     string `json:"old_user_id"`
 }
 
 type PRResponse struct {
-	PullRequestID     string     `json:"pull_request_id"`
-	PullRequestName   string     `json:"pull_request_name"`
-	AuthorID          string     `json:"author_id"`
-	Status            string     `json:"status"`
-	AssignedReviewers []string   `json:"assigned_reviewers"`
-	CreatedAt         *time.Time `json:"createdAt,omitempty"`
-	MergedAt          *time.Time `json:"mergedAt,omitempty"`
+	PullRequestID     string                `json:"pull_request_id"`
+	PullRequestName   string                `json:"pull_request_name"`
+	AuthorID          string                `json:"author_id"`
+	Status            string                `json:"status"`
+	IsDraft           bool                  `json:"is_draft"`
+	Repository        string                `json:"repository,omitempty"`
+	URL               string                `json:"url,omitempty"`
+	Labels            []string              `json:"labels,omitempty"`
+	LinesAdded        int                   `json:"lines_added"`
+	LinesRemoved      int                   `json:"lines_removed"`
+	Description       string                `json:"description,omitempty"`
+	AssignedReviewers []string              `json:"assigned_reviewers"`
+	ReviewerTags      map[string][]string   `json:"reviewer_tags,omitempty"`
+	UncoveredTags     []string              `json:"uncovered_tags,omitempty"`
+	Explanations      []ExplanationResponse `json:"explanations,omitempty"`
+	PolicyViolations  []string              `json:"policy_violations,omitempty"`
+	CreatedAt         *time.Time            `json:"createdAt,omitempty"`
+	MergedAt          *time.Time            `json:"mergedAt,omitempty"`
+}
+
+type ExclusionResponse struct {
+	UserID string `json:"user_id"`
+	Reason string `json:"reason"`
+}
+
+// ExplanationResponse tells why a reviewer was picked.
+type ExplanationResponse struct {
+	ReviewerID     string              `json:"reviewer_id"`
+	Strategy       string              `json:"strategy"`
+	PoolSize       int                 `json:"pool_size"`
+	Excluded       []ExclusionResponse `json:"excluded"`
+	MatchedTags    []string            `json:"matched_tags,omitempty"`
+	InWorkingHours bool                `json:"in_working_hours"`
+	Score          int                 `json:"score"`
+	Seed           int64               `json:"seed"`
+	ChosenAt       time.Time           `json:"chosen_at"`
+}
+
+type PRListResponse struct {
+	PullRequests []PRResponse `json:"pull_requests"`
+	NextCursor   string       `json:"next_cursor,omitempty"`
 }
 
 type UserResponse struct {
@@ -50,19 +184,152 @@ type UserResponse struct {
 	IsActive bool   `json:"is_active"`
 }
 
+type TeamDeleteResponse struct {
+	TeamName       string   `json:"team_name"`
+	RemovedMembers []string `json:"removed_members"`
+}
+
+type UserMoveResponse struct {
+	User          UserResponse `json:"user"`
+	ReassignedPRs []string     `json:"reassigned_prs"`
+	RemovedPRs    []string     `json:"removed_prs"`
+}
+
+type UserMoveDiff struct {
+	UserResponse
+	FromTeam string `json:"from_team"`
+}
+
+type TeamImportResponse struct {
+	DryRun       bool           `json:"dry_run"`
+	Applied      bool           `json:"applied"`
+	CreatedTeams []string       `json:"created_teams"`
+	Added        []UserResponse `json:"added"`
+	Updated      []UserResponse `json:"updated"`
+	Moved        []UserMoveDiff `json:"moved"`
+	Deactivated  []UserResponse `json:"deactivated"`
+	Unchanged    int            `json:"unchanged"`
+}
+
+type UserTagsResponse struct {
+	UserID string   `json:"user_id"`
+	Tags   []string `json:"tags"`
+}
+
+type UserDetailsResponse struct {
+	UserResponse
+	Tags            []string     `json:"tags"`
+	Seniority       string       `json:"seniority"`
+	IsAvailable     bool         `json:"is_available"`
+	OpenReviewCount int          `json:"open_review_count"`
+	AuthoredOpenPRs []string     `json:"authored_open_prs"`
+	Schedule        *ScheduleDTO `json:"schedule,omitempty"`
+	InWorkingHours  bool         `json:"in_working_hours"`
+}
+
+type UserScheduleResponse struct {
+	UserID   string       `json:"user_id"`
+	Schedule *ScheduleDTO `json:"schedule"`
+}
+
+type UserListResponse struct {
+	Users      []UserResponse `json:"users"`
+	NextCursor string         `json:"next_cursor,omitempty"`
+}
+
 type TeamResponse struct {
 	TeamName string      `json:"team_name"`
 	Members  []MemberDTO `json:"members"`
 }
 
 type PRShortResponse struct {
-	PullRequestID   string `json:"pull_request_id"`
-	PullRequestName string `json:"pull_request_name"`
-	AuthorID        string `json:"author_id"`
-	Status          string `json:"status"`
+	PullRequestID   string     `json:"pull_request_id"`
+	PullRequestName string     `json:"pull_request_name"`
+	AuthorID        string     `json:"author_id"`
+	Status          string     `json:"status"`
+	Repository      string     `json:"repository,omitempty"`
+	URL             string     `json:"url,omitempty"`
+	Labels          []string   `json:"labels,omitempty"`
+	CreatedAt       *time.Time `json:"createdAt,omitempty"`
 }
 
 type UserReviewsResponse struct {
 	UserID       string            `json:"user_id"`
 	PullRequests []PRShortResponse `json:"pull_requests"`
+	NextCursor   string            `json:"next_cursor,omitempty"`
+}
+
+type CodeownersRuleResponse struct {
+	Line    int      `json:"line"`
+	Pattern string   `json:"pattern"`
+	Owners  []string `json:"owners"`
+}
+
+type CodeownersResponse struct {
+	TeamName string                   `json:"team_name"`
+	Rules    []CodeownersRuleResponse `json:"rules"`
+}
+
+type EventResponse struct {
+	ID            int64     `json:"id"`
+	Type          string    `json:"type"`
+	UserID        string    `json:"user_id"`
+	PullRequestID string    `json:"pull_request_id"`
+	AuthorID      string    `json:"author_id"`
+	TeamName      string    `json:"team_name,omitempty"`
+	ReviewTeam    string    `json:"review_team,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type TeamSLAResponse struct {
+	TeamName      string `json:"team_name"`
+	ReviewSLA     string `json:"review_sla"`
+	ReassignAfter string `json:"reassign_after,omitempty"`
+	IsDefault     bool   `json:"is_default"`
+}
+
+type OverdueReviewResponse struct {
+	PullRequestID   string     `json:"pull_request_id"`
+	PullRequestName string     `json:"pull_request_name"`
+	AuthorID        string     `json:"author_id"`
+	TeamName        string     `json:"team_name"`
+	ReviewerID      string     `json:"reviewer_id"`
+	AssignedAt      time.Time  `json:"assigned_at"`
+	DueAt           time.Time  `json:"due_at"`
+	OverdueSeconds  int64      `json:"overdue_seconds"`
+	RemindedAt      *time.Time `json:"reminded_at,omitempty"`
+	ReassignAt      *time.Time `json:"reassign_at,omitempty"`
+}
+
+type OverdueListResponse struct {
+	Reviews []OverdueReviewResponse `json:"reviews"`
+}
+
+type MemberFairnessResponse struct {
+	UserID     string  `json:"user_id"`
+	Username   string  `json:"username"`
+	Reviews    int     `json:"reviews"`
+	ActiveDays float64 `json:"active_days"`
+	Expected   float64 `json:"expected_reviews"`
+	Deviation  float64 `json:"deviation"`
+}
+
+type FairnessResponse struct {
+	TeamName      string                   `json:"team_name"`
+	From          time.Time                `json:"from"`
+	To            time.Time                `json:"to"`
+	TotalReviews  int                      `json:"total_reviews"`
+	Gini          float64                  `json:"gini"`
+	Members       []MemberFairnessResponse `json:"members"`
+	OverAssigned  []MemberFairnessResponse `json:"over_assigned"`
+	UnderAssigned []MemberFairnessResponse `json:"under_assigned"`
+}
+
+type TeamRulesResponse struct {
+	TeamName string        `json:"team_name"`
+	Rules    []PairRuleDTO `json:"rules"`
+}
+
+type RepositoryListResponse struct {
+	Repositories []RepositoryDTO `json:"repositories"`
 }
@@ -0,0 +1,430 @@
+package delivery
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+)
+
+const (
+	maxIDLen       = 128
+	maxNameLen     = 255
+	maxMembers     = 1000
+	maxTags        = 50
+	maxTagLen      = 64
+	maxFiles       = 5000
+	maxPathLen     = 1024
+	maxCodeowners  = 64 << 10
+	maxRequiredTag = 20
+	maxPairRules   = 1000
+	maxLabels      = 50
+	maxURLLen      = 2048
+	maxDescription = 64 << 10
+)
+
+// repoPattern allows "name" and "owner/name".
+var repoPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*(/[A-Za-z0-9][A-Za-z0-9._-]*)?$`)
+
+type FieldError struct {
+	Field  string `json:"field"`
+	Reason string `json:"reason"`
+}
+
+// ValidationError lists every problem found in a request body.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	parts := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		parts = append(parts, f.Field+": "+f.Reason)
+	}
+	return "validation failed: " + strings.Join(parts, "; ")
+}
+
+type validator struct {
+	fields []FieldError
+}
+
+func (v *validator) add(field, reason string) {
+	v.fields = append(v.fields, FieldError{Field: field, Reason: reason})
+}
+
+func (v *validator) required(field, value string) bool {
+	if strings.TrimSpace(value) == "" {
+		v.add(field, "required")
+		return false
+	}
+	return true
+}
+
+func (v *validator) maxLen(field, value string, n int) {
+	if utf8.RuneCountInString(value) > n {
+		v.add(field, fmt.Sprintf("must be at most %d characters", n))
+	}
+}
+
+func (v *validator) id(field, value string) {
+	if !v.required(field, value) {
+		return
+	}
+	if len(value) > maxIDLen {
+		v.add(field, fmt.Sprintf("must be at most %d characters", maxIDLen))
+		return
+	}
+	if !models.ValidID(value) {
+		v.add(field, "may contain only letters, digits and . _ : -")
+	}
+}
+
+func (v *validator) repository(field, value string) {
+	if len(value) > maxNameLen {
+		v.add(field, fmt.Sprintf("must be at most %d characters", maxNameLen))
+		return
+	}
+	if !repoPattern.MatchString(value) {
+		v.add(field, `must be "name" or "owner/name" of letters, digits and . _ -`)
+	}
+}
+
+// prID checks a pull request id as returned by the service: a plain id or,
+// outside the default repository, "repository#id".
+func (v *validator) prID(field, value string) {
+	i := strings.LastIndexByte(value, '#')
+	if i < 0 {
+		v.id(field, value)
+		return
+	}
+	v.repository(field, value[:i])
+	v.id(field, value[i+1:])
+}
+
+func (v *validator) name(field, value string) {
+	if v.required(field, value) {
+		v.maxLen(field, value, maxNameLen)
+	}
+}
+
+func (v *validator) oneOf(field, value string, allowed ...string) {
+	if value == "" {
+		return
+	}
+	for _, a := range allowed {
+		if value == a {
+			return
+		}
+	}
+	v.add(field, "must be one of "+strings.Join(allowed, ", "))
+}
+
+func (v *validator) tags(field string, tags []string, limit int) {
+	if len(tags) > limit {
+		v.add(field, fmt.Sprintf("must have at most %d items", limit))
+		return
+	}
+	for i, t := range tags {
+		f := fmt.Sprintf("%s[%d]", field, i)
+		if v.required(f, t) {
+			v.maxLen(f, t, maxTagLen)
+		}
+	}
+}
+
+func (v *validator) url(field, value string) {
+	if value == "" {
+		return
+	}
+	if len(value) > maxURLLen {
+		v.add(field, fmt.Sprintf("must be at most %d characters", maxURLLen))
+		return
+	}
+	u, err := url.Parse(value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		v.add(field, "must be an absolute http or https URL")
+	}
+}
+
+func (v *validator) members(members []MemberDTO) {
+	if len(members) == 0 {
+		v.add("members", "required")
+		return
+	}
+	if len(members) > maxMembers {
+		v.add("members", fmt.Sprintf("must have at most %d items", maxMembers))
+		return
+	}
+	seen := make(map[string]bool, len(members))
+	for i, m := range members {
+		v.id(fmt.Sprintf("members[%d].user_id", i), m.UserID)
+		v.name(fmt.Sprintf("members[%d].username", i), m.Username)
+		if m.UserID != "" && seen[m.UserID] {
+			v.add(fmt.Sprintf("members[%d].user_id", i), "duplicate user_id")
+		}
+		seen[m.UserID] = true
+	}
+}
+
+func (v *validator) duration(field, value string) {
+	if value == "" {
+		return
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		v.add(field, "must be a duration such as 24h or 90m")
+		return
+	}
+	if d <= 0 {
+		v.add(field, "must be positive")
+	}
+}
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday, "mon": time.Monday, "tue": time.Tuesday, "wed": time.Wednesday,
+	"thu": time.Thursday, "fri": time.Friday, "sat": time.Saturday,
+}
+
+// ParseWeekday accepts three-letter weekday names in any case.
+func ParseWeekday(s string) (time.Weekday, bool) {
+	d, ok := weekdays[strings.ToLower(s)]
+	return d, ok
+}
+
+// ParseClock converts an "HH:MM" time to minutes after midnight; "24:00" is
+// accepted as the end of the day.
+func ParseClock(s string) (int, bool) {
+	if len(s) != 5 || s[2] != ':' {
+		return 0, false
+	}
+	for _, i := range []int{0, 1, 3, 4} {
+		if s[i] < '0' || s[i] > '9' {
+			return 0, false
+		}
+	}
+	h := int(s[0]-'0')*10 + int(s[1]-'0')
+	m := int(s[3]-'0')*10 + int(s[4]-'0')
+	if m > 59 || h > 24 || (h == 24 && m != 0) {
+		return 0, false
+	}
+	return h*60 + m, true
+}
+
+func (v *validator) schedule(field string, s *ScheduleDTO) {
+	if s == nil {
+		return
+	}
+	if v.required(field+".timezone", s.Timezone) {
+		if _, err := time.LoadLocation(s.Timezone); err != nil || s.Timezone == "Local" {
+			v.add(field+".timezone", "must be an IANA timezone such as Europe/Moscow")
+		}
+	}
+	start, okStart := ParseClock(s.Start)
+	if !okStart {
+		v.add(field+".start", "must be a time in HH:MM format")
+	}
+	end, okEnd := ParseClock(s.End)
+	if !okEnd {
+		v.add(field+".end", "must be a time in HH:MM format")
+	}
+	if okStart && okEnd && start >= end {
+		v.add(field+".end", "must be after start")
+	}
+	for i, d := range s.Days {
+		if _, ok := ParseWeekday(d); !ok {
+			v.add(fmt.Sprintf("%s.days[%d]", field, i), "must be one of mon, tue, wed, thu, fri, sat, sun")
+		}
+	}
+}
+
+func (v *validator) err() error {
+	if len(v.fields) == 0 {
+		return nil
+	}
+	return &ValidationError{Fields: v.fields}
+}
+
+func (t TeamDTO) Validate() error {
+	var v validator
+	v.name("team_name", t.TeamName)
+	v.members(t.Members)
+	return v.err()
+}
+
+func (t TeamUpdateDTO) Validate() error {
+	var v validator
+	v.name("team_name", t.TeamName)
+	v.members(t.Members)
+	v.oneOf("open_prs_policy", t.OpenPRsPolicy, "refuse", "reassign")
+	return v.err()
+}
+
+func (t TeamMemberRemoveDTO) Validate() error {
+	var v validator
+	v.name("team_name", t.TeamName)
+	v.id("user_id", t.UserID)
+	v.oneOf("open_prs_policy", t.OpenPRsPolicy, "refuse", "reassign")
+	return v.err()
+}
+
+func (t TeamCodeownersDTO) Validate() error {
+	var v validator
+	v.name("team_name", t.TeamName)
+	if len(t.Content) > maxCodeowners {
+		v.add("content", fmt.Sprintf("must be at most %d bytes", maxCodeowners))
+	}
+	return v.err()
+}
+
+func (t TeamRulesDTO) Validate() error {
+	var v validator
+	v.name("team_name", t.TeamName)
+	if t.Rules == nil {
+		v.add("rules", "required")
+	}
+	if len(t.Rules) > maxPairRules {
+		v.add("rules", fmt.Sprintf("must have at most %d items", maxPairRules))
+		return v.err()
+	}
+	for i, r := range t.Rules {
+		field := fmt.Sprintf("rules[%d].", i)
+		if v.required(field+"type", r.Type) {
+			v.oneOf(field+"type", r.Type, "never", "always")
+		}
+		v.id(field+"reviewer_id", r.ReviewerID)
+		v.id(field+"author_id", r.AuthorID)
+		if r.ReviewerID != "" && r.ReviewerID == r.AuthorID {
+			v.add(field+"reviewer_id", "must differ from author_id")
+		}
+	}
+	return v.err()
+}
+
+func (t TeamMentorshipDTO) Validate() error {
+	var v validator
+	v.name("team_name", t.TeamName)
+	return v.err()
+}
+
+func (t TeamLargePRsDTO) Validate() error {
+	var v validator
+	v.name("team_name", t.TeamName)
+	if t.MinLines < 0 {
+		v.add("min_lines", "must not be negative")
+	}
+	return v.err()
+}
+
+func (r RepositoryDTO) Validate() error {
+	var v validator
+	if v.required("repository", r.Repository) {
+		v.repository("repository", r.Repository)
+	}
+	if r.TeamName != "" {
+		v.maxLen("team_name", r.TeamName, maxNameLen)
+	}
+	return v.err()
+}
+
+func (t TeamSLADTO) Validate() error {
+	var v validator
+	v.name("team_name", t.TeamName)
+	if v.required("review_sla", t.ReviewSLA) {
+		v.duration("review_sla", t.ReviewSLA)
+	}
+	v.duration("reassign_after", t.ReassignAfter)
+	return v.err()
+}
+
+func (u UserActiveDTO) Validate() error {
+	var v validator
+	v.id("user_id", u.UserID)
+	return v.err()
+}
+
+func (u UserMoveDTO) Validate() error {
+	var v validator
+	v.id("user_id", u.UserID)
+	v.name("team_name", u.TeamName)
+	v.oneOf("review_policy", u.ReviewPolicy, "keep", "reassign")
+	return v.err()
+}
+
+func (u UserTagsDTO) Validate() error {
+	var v validator
+	v.id("user_id", u.UserID)
+	v.tags("tags", u.Tags, maxTags)
+	return v.err()
+}
+
+func (u UserSeniorityDTO) Validate() error {
+	var v validator
+	v.id("user_id", u.UserID)
+	if v.required("seniority", u.Seniority) {
+		v.oneOf("seniority", u.Seniority, "junior", "middle", "senior")
+	}
+	return v.err()
+}
+
+func (u UserScheduleDTO) Validate() error {
+	var v validator
+	v.id("user_id", u.UserID)
+	v.schedule("schedule", u.Schedule)
+	return v.err()
+}
+
+func (p PRCreateDTO) Validate() error {
+	var v validator
+	v.id("pull_request_id", p.PullRequestID)
+	v.name("pull_request_name", p.PullRequestName)
+	v.id("author_id", p.AuthorID)
+	if len(p.ChangedFiles) > maxFiles {
+		v.add("changed_files", fmt.Sprintf("must have at most %d items", maxFiles))
+	} else {
+		for i, f := range p.ChangedFiles {
+			field := fmt.Sprintf("changed_files[%d]", i)
+			if v.required(field, f) {
+				v.maxLen(field, f, maxPathLen)
+			}
+		}
+	}
+	v.tags("required_tags", p.RequiredTags, maxRequiredTag)
+	if p.Repository != "" {
+		v.repository("repository", p.Repository)
+	}
+	v.url("url", p.URL)
+	v.tags("labels", p.Labels, maxLabels)
+	if p.LinesAdded < 0 {
+		v.add("lines_added", "must not be negative")
+	}
+	if p.LinesRemoved < 0 {
+		v.add("lines_removed", "must not be negative")
+	}
+	if len(p.Description) > maxDescription {
+		v.add("description", fmt.Sprintf("must be at most %d bytes", maxDescription))
+	}
+	return v.err()
+}
+
+func (p PRMergeDTO) Validate() error {
+	var v validator
+	v.prID("pull_request_id", p.PullRequestID)
+	return v.err()
+}
+
+func (p PRReadyDTO) Validate() error {
+	var v validator
+	v.prID("pull_request_id", p.PullRequestID)
+	return v.err()
+}
+
+func (p PRReassignDTO) Validate() error {
+	var v validator
+	v.prID("pull_request_id", p.PullRequestID)
+	v.id("old_user_id", p.OldUserID)
+	return v.err()
+}
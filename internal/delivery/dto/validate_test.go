@@ -0,0 +1,109 @@
+package delivery
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestIDPattern(t *testing.T) {
+	tests := []struct {
+		id string
+		ok bool
+	}{
+		{"u1", true},
+		{"user.name_1:x-2", true},
+		{"0", true},
+		{strings.Repeat("a", maxIDLen), true},
+		{"", false},
+		{" ", false},
+		{strings.Repeat("a", maxIDLen+1), false},
+		{"-u1", false},
+		{".u1", false},
+		{"repo#1", false},
+		{"a/b", false},
+		{"u 1", false},
+		{"юзер", false},
+	}
+	for _, tt := range tests {
+		var v validator
+		v.id("user_id", tt.id)
+		if ok := len(v.fields) == 0; ok != tt.ok {
+			t.Errorf("id(%q): ok = %v, want %v (%v)", tt.id, ok, tt.ok, v.fields)
+		}
+	}
+}
+
+func TestPRID(t *testing.T) {
+	tests := []struct {
+		id     string
+		fields []string
+	}{
+		{"pr-1", nil},
+		{"backend#pr-1", nil},
+		{"acme/backend#pr-1", nil},
+		// Only the last '#' separates the repository.
+		{"a#b#c", []string{"pull_request_id"}},
+		{"#pr-1", []string{"pull_request_id"}},
+		{"backend#", []string{"pull_request_id"}},
+		{"a/b/c#pr-1", []string{"pull_request_id"}},
+		{"backend#pr 1", []string{"pull_request_id"}},
+		{"", []string{"pull_request_id"}},
+	}
+	for _, tt := range tests {
+		err := PRMergeDTO{PullRequestID: tt.id}.Validate()
+		if got := errorFields(t, err); !slices.Equal(got, tt.fields) {
+			t.Errorf("%q: fields %v, want %v", tt.id, got, tt.fields)
+		}
+	}
+}
+
+func TestValidationErrorAggregatesFields(t *testing.T) {
+	tests := []struct {
+		name   string
+		dto    interface{ Validate() error }
+		fields []string
+	}{
+		{"valid team", TeamDTO{TeamName: "backend", Members: []MemberDTO{{UserID: "u1", Username: "Alice"}}}, nil},
+		{"empty team", TeamDTO{}, []string{"team_name", "members"}},
+		{"bad members", TeamDTO{TeamName: "backend", Members: []MemberDTO{
+			{UserID: "u1", Username: "Alice"},
+			{UserID: "u#2", Username: ""},
+			{UserID: "u1", Username: "Bob"},
+		}}, []string{"members[1].user_id", "members[1].username", "members[2].user_id"}},
+		{"pr create", PRCreateDTO{
+			PullRequestID: "pr 1",
+			AuthorID:      "u1",
+			URL:           "ftp://example.com",
+			LinesAdded:    -1,
+			Labels:        []string{"ok", ""},
+		}, []string{"pull_request_id", "pull_request_name", "url", "labels[1]", "lines_added"}},
+		{"reassign", PRReassignDTO{PullRequestID: "backend#pr-1"}, []string{"old_user_id"}},
+		{"move", UserMoveDTO{UserID: "u1", TeamName: "backend", ReviewPolicy: "drop"}, []string{"review_policy"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errorFields(t, tt.dto.Validate()); !slices.Equal(got, tt.fields) {
+				t.Errorf("fields %v, want %v", got, tt.fields)
+			}
+		})
+	}
+}
+
+// errorFields lists the fields of a validation error in the order found.
+func errorFields(t *testing.T, err error) []string {
+	t.Helper()
+	if err == nil {
+		return nil
+	}
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("error %v is not a *ValidationError", err)
+	}
+	var fields []string
+	for _, f := range verr.Fields {
+		fields = append(fields, f.Field)
+	}
+	return fields
+}
@@ -0,0 +1,160 @@
+package delivery
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	d "github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/delivery/dto"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/repository"
+)
+
+const (
+	eventReplayPage   = 500
+	eventHeartbeat    = 15 * time.Second
+	eventRetryMillis  = 3000
+	lastEventIDHeader = "Last-Event-ID"
+)
+
+// StreamEvents pushes assignment events for a user or team as Server-Sent
+// Events. A client reconnecting with Last-Event-ID first gets the logged
+// events it missed, then live ones.
+func (h *Handler) StreamEvents(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	filter := models.EventFilter{UserID: q.Get("user_id"), TeamName: q.Get("team_name")}
+	if filter.UserID == "" && filter.TeamName == "" {
+		h.sendError(w, http.StatusBadRequest, "INVALID_REQUEST", "user_id or team_name required")
+		return
+	}
+
+	lastID := r.Header.Get(lastEventIDHeader)
+	if lastID == "" {
+		lastID = q.Get("last_event_id")
+	}
+	resume := lastID != ""
+	if resume {
+		id, err := strconv.ParseInt(lastID, 10, 64)
+		if err != nil || id < 0 {
+			h.sendError(w, http.StatusBadRequest, "INVALID_REQUEST", "Last-Event-ID must be a non-negative integer")
+			return
+		}
+		filter.AfterID = id
+	}
+
+	if filter.UserID != "" {
+		if _, err := h.service.GetUser(r.Context(), filter.UserID); err != nil {
+			if errors.Is(err, repository.ErrUserNotFound) {
+				h.sendError(w, http.StatusNotFound, "NOT_FOUND", "user not found")
+			} else {
+				h.sendUnexpected(w, "get user failed", err)
+			}
+			return
+		}
+	}
+	if filter.TeamName != "" {
+		if _, err := h.service.GetTeam(r.Context(), filter.TeamName); err != nil {
+			if errors.Is(err, repository.ErrTeamNotFound) {
+				h.sendError(w, http.StatusNotFound, "NOT_FOUND", "team not found")
+			} else {
+				h.sendUnexpected(w, "get team failed", err)
+			}
+			return
+		}
+	}
+
+	// Subscribe before replaying so nothing committed in between is lost;
+	// events seen in both are sent once.
+	sub := h.events.Subscribe(filter)
+	defer sub.Close()
+
+	rc := http.NewResponseController(w)
+	_ = rc.SetWriteDeadline(time.Time{})
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	if _, err := fmt.Fprintf(w, "retry: %d\n\n", eventRetryMillis); err != nil {
+		return
+	}
+	if err := rc.Flush(); err != nil {
+		h.logger.Error("event stream cannot be flushed", "err", err)
+		return
+	}
+
+	replayed := make(map[int64]bool)
+	if resume {
+		page := filter
+		page.Limit = eventReplayPage
+		for {
+			events, err := h.events.Replay(r.Context(), page)
+			if err != nil {
+				h.logger.Error("replay events failed", "err", err)
+				return
+			}
+			for _, ev := range events {
+				if writeEvent(w, ev) != nil {
+					return
+				}
+				replayed[ev.ID] = true
+				page.AfterID = ev.ID
+			}
+			if err := rc.Flush(); err != nil {
+				return
+			}
+			if len(events) < eventReplayPage {
+				break
+			}
+		}
+	}
+
+	heartbeat := time.NewTicker(eventHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case ev, ok := <-sub.C:
+			if !ok {
+				// Dropped for falling behind; the client resumes from the log.
+				return
+			}
+			if replayed[ev.ID] {
+				delete(replayed, ev.ID)
+				continue
+			}
+			if writeEvent(w, ev) != nil {
+				return
+			}
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+func writeEvent(w http.ResponseWriter, ev models.Event) error {
+	data, err := json.Marshal(d.EventResponse{
+		ID:            ev.ID,
+		Type:          ev.Type,
+		UserID:        ev.UserID,
+		PullRequestID: ev.PullRequestID,
+		AuthorID:      ev.AuthorID,
+		TeamName:      ev.TeamName,
+		ReviewTeam:    ev.ReviewTeam,
+		CreatedAt:     ev.CreatedAt,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", ev.ID, ev.Type, data)
+	return err
+}
@@ -0,0 +1,501 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/codeowners"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+	pb "github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/pb/prservice/v1"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/repository"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/usecase"
+)
+
+// Service exposes usecase.PRService over gRPC.
+type Service struct {
+	pb.UnimplementedPRServiceServer
+	service usecase.PRService
+	logger  *slog.Logger
+}
+
+func NewService(service usecase.PRService, logger *slog.Logger) *Service {
+	return &Service{service: service, logger: logger}
+}
+
+func (s *Service) CreateTeam(ctx context.Context, req *pb.CreateTeamRequest) (*pb.TeamResponse, error) {
+	team, err := s.service.CreateTeam(ctx, teamFromPB(req.GetTeam()))
+	if err != nil {
+		return nil, s.toStatus("create team failed", err)
+	}
+	return &pb.TeamResponse{Team: teamToPB(team)}, nil
+}
+
+func (s *Service) GetTeam(ctx context.Context, req *pb.GetTeamRequest) (*pb.TeamResponse, error) {
+	team, err := s.service.GetTeam(ctx, req.GetTeamName())
+	if err != nil {
+		return nil, s.toStatus("get team failed", err)
+	}
+	return &pb.TeamResponse{Team: teamToPB(team)}, nil
+}
+
+func (s *Service) UpdateTeam(ctx context.Context, req *pb.UpdateTeamRequest) (*pb.TeamResponse, error) {
+	team, err := s.service.UpdateTeam(ctx, teamFromPB(req.GetTeam()), models.OpenPRPolicy(req.GetOpenPrsPolicy()))
+	if err != nil {
+		return nil, s.toStatus("update team failed", err)
+	}
+	return &pb.TeamResponse{Team: teamToPB(team)}, nil
+}
+
+func (s *Service) RemoveTeamMember(ctx context.Context, req *pb.RemoveTeamMemberRequest) (*pb.TeamResponse, error) {
+	team, err := s.service.RemoveTeamMember(ctx, req.GetTeamName(), req.GetUserId(), models.OpenPRPolicy(req.GetOpenPrsPolicy()))
+	if err != nil {
+		return nil, s.toStatus("remove member failed", err)
+	}
+	return &pb.TeamResponse{Team: teamToPB(team)}, nil
+}
+
+func (s *Service) DeleteTeam(ctx context.Context, req *pb.DeleteTeamRequest) (*pb.DeleteTeamResponse, error) {
+	removed, err := s.service.DeleteTeam(ctx, req.GetTeamName(), models.OpenPRPolicy(req.GetOpenPrsPolicy()))
+	if err != nil {
+		return nil, s.toStatus("delete team failed", err)
+	}
+	return &pb.DeleteTeamResponse{TeamName: req.GetTeamName(), RemovedMembers: removed}, nil
+}
+
+func (s *Service) SetTeamCodeowners(ctx context.Context, req *pb.SetTeamCodeownersRequest) (*pb.SetTeamCodeownersResponse, error) {
+	rs, err := s.service.SetTeamCodeowners(ctx, req.GetTeamName(), req.GetContent())
+	if err != nil {
+		return nil, s.toStatus("set codeowners failed", err)
+	}
+	resp := &pb.SetTeamCodeownersResponse{TeamName: req.GetTeamName()}
+	for _, rule := range rs.Rules {
+		resp.Rules = append(resp.Rules, &pb.CodeownersRule{
+			Line:    int32(rule.Line),
+			Pattern: rule.Pattern,
+			Owners:  rule.Owners,
+		})
+	}
+	return resp, nil
+}
+
+func (s *Service) SetTeamMentorship(ctx context.Context, req *pb.SetTeamMentorshipRequest) (*pb.SetTeamMentorshipResponse, error) {
+	if err := s.service.SetTeamMentorship(ctx, req.GetTeamName(), req.GetEnabled()); err != nil {
+		return nil, s.toStatus("set team mentorship failed", err)
+	}
+	return &pb.SetTeamMentorshipResponse{TeamName: req.GetTeamName(), Enabled: req.GetEnabled()}, nil
+}
+
+func (s *Service) SetTeamLargePRs(ctx context.Context, req *pb.SetTeamLargePRsRequest) (*pb.SetTeamLargePRsResponse, error) {
+	if err := s.service.SetTeamLargePRLines(ctx, req.GetTeamName(), int(req.GetMinLines())); err != nil {
+		return nil, s.toStatus("set team large prs failed", err)
+	}
+	return &pb.SetTeamLargePRsResponse{TeamName: req.GetTeamName(), MinLines: req.GetMinLines()}, nil
+}
+
+func (s *Service) SetTeamRules(ctx context.Context, req *pb.SetTeamRulesRequest) (*pb.TeamRulesResponse, error) {
+	rules := make([]models.PairRule, 0, len(req.GetRules()))
+	for _, r := range req.GetRules() {
+		rules = append(rules, models.PairRule{Kind: r.GetType(), ReviewerID: r.GetReviewerId(), AuthorID: r.GetAuthorId()})
+	}
+	stored, err := s.service.SetPairRules(ctx, req.GetTeamName(), rules)
+	if err != nil {
+		return nil, s.toStatus("set team rules failed", err)
+	}
+	return teamRulesToPB(req.GetTeamName(), stored), nil
+}
+
+func (s *Service) GetTeamRules(ctx context.Context, req *pb.GetTeamRulesRequest) (*pb.TeamRulesResponse, error) {
+	rules, err := s.service.GetPairRules(ctx, req.GetTeamName())
+	if err != nil {
+		return nil, s.toStatus("get team rules failed", err)
+	}
+	return teamRulesToPB(req.GetTeamName(), rules), nil
+}
+
+func (s *Service) SetRepository(ctx context.Context, req *pb.SetRepositoryRequest) (*pb.RepositoryResponse, error) {
+	repo, err := s.service.SetRepository(ctx, models.Repository{
+		Name:     req.GetRepository().GetRepository(),
+		TeamName: req.GetRepository().GetTeamName(),
+	})
+	if err != nil {
+		return nil, s.toStatus("set repository failed", err)
+	}
+	return &pb.RepositoryResponse{Repository: repositoryToPB(repo)}, nil
+}
+
+func (s *Service) ListRepositories(ctx context.Context, _ *pb.ListRepositoriesRequest) (*pb.ListRepositoriesResponse, error) {
+	repos, err := s.service.ListRepositories(ctx)
+	if err != nil {
+		return nil, s.toStatus("list repositories failed", err)
+	}
+	resp := &pb.ListRepositoriesResponse{}
+	for i := range repos {
+		resp.Repositories = append(resp.Repositories, repositoryToPB(&repos[i]))
+	}
+	return resp, nil
+}
+
+func (s *Service) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.GetUserResponse, error) {
+	u, err := s.service.GetUser(ctx, req.GetUserId())
+	if err != nil {
+		return nil, s.toStatus("get user failed", err)
+	}
+	return &pb.GetUserResponse{
+		User:            userToPB(&u.User),
+		Tags:            u.Tags,
+		IsAvailable:     u.IsAvailable(),
+		OpenReviewCount: int32(u.OpenReviewCount),
+		AuthoredOpenPrs: u.AuthoredOpenPRs,
+	}, nil
+}
+
+func (s *Service) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	after, err := models.DecodeIDCursor(req.GetCursor())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	filter := models.UserFilter{
+		TeamName:       req.GetTeamName(),
+		IsActive:       req.IsActive,
+		UsernamePrefix: req.GetUsernamePrefix(),
+		Limit:          int(req.GetLimit()),
+		AfterID:        after,
+	}
+	users, next, err := s.service.ListUsers(ctx, filter)
+	if err != nil {
+		return nil, s.toStatus("list users failed", err)
+	}
+	resp := &pb.ListUsersResponse{NextCursor: models.EncodeIDCursor(next)}
+	for i := range users {
+		resp.Users = append(resp.Users, userToPB(&users[i]))
+	}
+	return resp, nil
+}
+
+func (s *Service) SetUserActive(ctx context.Context, req *pb.SetUserActiveRequest) (*pb.UserResponse, error) {
+	u, err := s.service.SetUserActive(ctx, req.GetUserId(), req.GetIsActive())
+	if err != nil {
+		return nil, s.toStatus("set active failed", err)
+	}
+	return &pb.UserResponse{User: userToPB(u)}, nil
+}
+
+func (s *Service) SetUserTags(ctx context.Context, req *pb.SetUserTagsRequest) (*pb.SetUserTagsResponse, error) {
+	tags, err := s.service.SetUserTags(ctx, req.GetUserId(), req.GetTags())
+	if err != nil {
+		return nil, s.toStatus("set tags failed", err)
+	}
+	return &pb.SetUserTagsResponse{UserId: req.GetUserId(), Tags: tags}, nil
+}
+
+func (s *Service) SetUserSeniority(ctx context.Context, req *pb.SetUserSeniorityRequest) (*pb.SetUserSeniorityResponse, error) {
+	if err := s.service.SetUserSeniority(ctx, req.GetUserId(), req.GetSeniority()); err != nil {
+		return nil, s.toStatus("set seniority failed", err)
+	}
+	return &pb.SetUserSeniorityResponse{UserId: req.GetUserId(), Seniority: req.GetSeniority()}, nil
+}
+
+func (s *Service) MoveUser(ctx context.Context, req *pb.MoveUserRequest) (*pb.MoveUserResponse, error) {
+	u, handover, err := s.service.MoveUser(ctx, req.GetUserId(), req.GetTeamName(), models.MovePolicy(req.GetReviewPolicy()))
+	if err != nil {
+		return nil, s.toStatus("move user failed", err)
+	}
+	return &pb.MoveUserResponse{User: userToPB(u), ReassignedPrs: handover.Reassigned, RemovedPrs: handover.Removed}, nil
+}
+
+func (s *Service) GetUserReviews(ctx context.Context, req *pb.GetUserReviewsRequest) (*pb.GetUserReviewsResponse, error) {
+	after, err := models.DecodeCursor(req.GetPage().GetCursor())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	filter := models.ReviewFilter{
+		Status:        statusFromPB(req.GetStatus()),
+		AuthorID:      req.GetAuthorId(),
+		Repository:    req.GetRepository(),
+		Label:         req.GetLabel(),
+		CreatedAfter:  timeFromPB(req.GetCreated().GetCreatedAfter()),
+		CreatedBefore: timeFromPB(req.GetCreated().GetCreatedBefore()),
+		Ascending:     req.GetPage().GetAscending(),
+		Limit:         int(req.GetPage().GetLimit()),
+		After:         after,
+	}
+	prs, next, err := s.service.GetUserReviews(ctx, req.GetUserId(), filter)
+	if err != nil {
+		return nil, s.toStatus("get reviews failed", err)
+	}
+	resp := &pb.GetUserReviewsResponse{UserId: req.GetUserId(), NextCursor: next.Encode()}
+	for _, pr := range prs {
+		resp.PullRequests = append(resp.PullRequests, &pb.PullRequestShort{
+			PullRequestId:   pr.ID,
+			PullRequestName: pr.Name,
+			AuthorId:        pr.AuthorID,
+			Status:          statusToPB(pr.Status),
+			CreatedAt:       timeToPB(pr.CreatedAt),
+			Repository:      pr.Repository,
+			Url:             pr.URL,
+			Labels:          pr.Labels,
+		})
+	}
+	return resp, nil
+}
+
+func (s *Service) CreatePullRequest(ctx context.Context, req *pb.CreatePullRequestRequest) (*pb.PullRequestResponse, error) {
+	pr, err := s.service.CreatePR(ctx, models.PullRequest{
+		ID:           req.GetPullRequestId(),
+		Name:         req.GetPullRequestName(),
+		AuthorID:     req.GetAuthorId(),
+		ChangedFiles: req.GetChangedFiles(),
+		RequiredTags: req.GetRequiredTags(),
+		IsDraft:      req.GetIsDraft(),
+		Repository:   req.GetRepository(),
+		URL:          req.GetUrl(),
+		Labels:       req.GetLabels(),
+		LinesAdded:   int(req.GetLinesAdded()),
+		LinesRemoved: int(req.GetLinesRemoved()),
+		Description:  req.GetDescription(),
+	})
+	if err != nil {
+		return nil, s.toStatus("create pr failed", err)
+	}
+	return &pb.PullRequestResponse{Pr: prToPB(pr)}, nil
+}
+
+func (s *Service) GetPullRequest(ctx context.Context, req *pb.GetPullRequestRequest) (*pb.PullRequestResponse, error) {
+	pr, err := s.service.GetPR(ctx, req.GetPullRequestId())
+	if err != nil {
+		return nil, s.toStatus("get pr failed", err)
+	}
+	return &pb.PullRequestResponse{Pr: prToPB(pr)}, nil
+}
+
+func (s *Service) ListPullRequests(ctx context.Context, req *pb.ListPullRequestsRequest) (*pb.ListPullRequestsResponse, error) {
+	after, err := models.DecodeCursor(req.GetPage().GetCursor())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	filter := models.PRFilter{
+		TeamName:      req.GetTeamName(),
+		AuthorID:      req.GetAuthorId(),
+		Repository:    req.GetRepository(),
+		ReviewerID:    req.GetReviewerId(),
+		Status:        statusFromPB(req.GetStatus()),
+		NameContains:  req.GetName(),
+		CreatedAfter:  timeFromPB(req.GetCreated().GetCreatedAfter()),
+		CreatedBefore: timeFromPB(req.GetCreated().GetCreatedBefore()),
+		Ascending:     req.GetPage().GetAscending(),
+		Limit:         int(req.GetPage().GetLimit()),
+		After:         after,
+	}
+	prs, next, err := s.service.ListPRs(ctx, filter)
+	if err != nil {
+		return nil, s.toStatus("list prs failed", err)
+	}
+	resp := &pb.ListPullRequestsResponse{NextCursor: next.Encode()}
+	for i := range prs {
+		resp.PullRequests = append(resp.PullRequests, prToPB(&prs[i]))
+	}
+	return resp, nil
+}
+
+func (s *Service) ReadyPullRequest(ctx context.Context, req *pb.ReadyPullRequestRequest) (*pb.PullRequestResponse, error) {
+	pr, err := s.service.ReadyPR(ctx, req.GetPullRequestId())
+	if err != nil {
+		return nil, s.toStatus("ready pr failed", err)
+	}
+	return &pb.PullRequestResponse{Pr: prToPB(pr)}, nil
+}
+
+func (s *Service) MergePullRequest(ctx context.Context, req *pb.MergePullRequestRequest) (*pb.PullRequestResponse, error) {
+	pr, err := s.service.MergePR(ctx, req.GetPullRequestId())
+	if err != nil {
+		return nil, s.toStatus("merge pr failed", err)
+	}
+	return &pb.PullRequestResponse{Pr: prToPB(pr)}, nil
+}
+
+func (s *Service) ReassignReviewer(ctx context.Context, req *pb.ReassignReviewerRequest) (*pb.ReassignReviewerResponse, error) {
+	pr, replacedBy, err := s.service.ReassignReviewer(ctx, req.GetPullRequestId(), req.GetOldUserId())
+	if err != nil {
+		return nil, s.toStatus("reassign failed", err)
+	}
+	return &pb.ReassignReviewerResponse{Pr: prToPB(pr), ReplacedBy: replacedBy}, nil
+}
+
+// toStatus maps domain errors to gRPC status codes.
+func (s *Service) toStatus(msg string, err error) error {
+	var (
+		openErr  *usecase.OpenPRsError
+		parseErr *codeowners.ParseError
+	)
+	switch {
+	case errors.Is(err, repository.ErrTeamNotFound),
+		errors.Is(err, repository.ErrUserNotFound),
+		errors.Is(err, repository.ErrUserNoTeam),
+		errors.Is(err, repository.ErrPRNotFound),
+		errors.Is(err, repository.ErrRepositoryNotFound),
+		errors.Is(err, usecase.ErrNotTeamMember):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, usecase.ErrTeamExists), errors.Is(err, usecase.ErrPRExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, usecase.ErrPRMerged),
+		errors.Is(err, usecase.ErrNotAssigned),
+		errors.Is(err, usecase.ErrAlreadyInTeam),
+		errors.Is(err, repository.ErrNoCandidate),
+		errors.Is(err, usecase.ErrRulesUnsatisfiable),
+		errors.As(err, &openErr):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, usecase.ErrInvalidArgument),
+		errors.Is(err, usecase.ErrInvalidFilter),
+		errors.Is(err, usecase.ErrInvalidPolicy),
+		errors.As(err, &parseErr):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	default:
+		s.logger.Error(msg, "err", err)
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+func teamRulesToPB(teamName string, rules []models.PairRule) *pb.TeamRulesResponse {
+	resp := &pb.TeamRulesResponse{TeamName: teamName}
+	for _, r := range rules {
+		resp.Rules = append(resp.Rules, &pb.PairRule{Type: r.Kind, ReviewerId: r.ReviewerID, AuthorId: r.AuthorID})
+	}
+	return resp
+}
+
+func teamFromPB(t *pb.Team) models.Team {
+	team := models.Team{Name: t.GetTeamName()}
+	for _, m := range t.GetMembers() {
+		team.Members = append(team.Members, models.Member{
+			ID:       m.GetUserId(),
+			Username: m.GetUsername(),
+			IsActive: m.GetIsActive(),
+		})
+	}
+	return team
+}
+
+func teamToPB(t *models.Team) *pb.Team {
+	team := &pb.Team{TeamName: t.Name}
+	for _, m := range t.Members {
+		team.Members = append(team.Members, &pb.Member{
+			UserId:   m.ID,
+			Username: m.Username,
+			IsActive: m.IsActive,
+		})
+	}
+	return team
+}
+
+func repositoryToPB(r *models.Repository) *pb.Repository {
+	return &pb.Repository{Repository: r.Name, TeamName: r.TeamName}
+}
+
+func userToPB(u *models.User) *pb.User {
+	return &pb.User{
+		UserId:   u.ID,
+		Username: u.Username,
+		TeamName: u.TeamName,
+		IsActive: u.IsActive,
+	}
+}
+
+func prToPB(pr *models.PullRequest) *pb.PullRequest {
+	out := &pb.PullRequest{
+		PullRequestId:     pr.ID,
+		PullRequestName:   pr.Name,
+		AuthorId:          pr.AuthorID,
+		Status:            statusToPB(pr.Status),
+		IsDraft:           pr.IsDraft,
+		Repository:        pr.Repository,
+		Url:               pr.URL,
+		Labels:            pr.Labels,
+		LinesAdded:        int32(pr.LinesAdded),
+		LinesRemoved:      int32(pr.LinesRemoved),
+		Description:       pr.Description,
+		AssignedReviewers: pr.AssignedReviewers,
+		UncoveredTags:     pr.UncoveredTags,
+		CreatedAt:         timeToPB(pr.CreatedAt),
+		MergedAt:          timeToPB(pr.MergedAt),
+		Explanations:      explanationsToPB(pr.Explanations),
+		PolicyViolations:  pr.PolicyViolations,
+	}
+	if len(pr.ReviewerTags) > 0 {
+		out.ReviewerTags = make(map[string]*pb.StringList, len(pr.ReviewerTags))
+		for id, tags := range pr.ReviewerTags {
+			out.ReviewerTags[id] = &pb.StringList{Values: tags}
+		}
+	}
+	return out
+}
+
+func explanationsToPB(explanations []models.ReviewerExplanation) []*pb.ReviewerExplanation {
+	if len(explanations) == 0 {
+		return nil
+	}
+	out := make([]*pb.ReviewerExplanation, 0, len(explanations))
+	for _, e := range explanations {
+		x := &pb.ReviewerExplanation{
+			ReviewerId:     e.ReviewerID,
+			Strategy:       e.Strategy,
+			PoolSize:       int32(e.PoolSize),
+			MatchedTags:    e.MatchedTags,
+			InWorkingHours: e.InWorkingHours,
+			Score:          int32(e.Score),
+			Seed:           e.Seed,
+			ChosenAt:       timestamppb.New(e.CreatedAt),
+		}
+		for _, ex := range e.Excluded {
+			x.Excluded = append(x.Excluded, &pb.Exclusion{UserId: ex.UserID, Reason: ex.Reason})
+		}
+		out = append(out, x)
+	}
+	return out
+}
+
+func statusToPB(s string) pb.PullRequestStatus {
+	switch s {
+	case "OPEN":
+		return pb.PullRequestStatus_PULL_REQUEST_STATUS_OPEN
+	case "MERGED":
+		return pb.PullRequestStatus_PULL_REQUEST_STATUS_MERGED
+	default:
+		return pb.PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+	}
+}
+
+func statusFromPB(s pb.PullRequestStatus) string {
+	switch s {
+	case pb.PullRequestStatus_PULL_REQUEST_STATUS_OPEN:
+		return "OPEN"
+	case pb.PullRequestStatus_PULL_REQUEST_STATUS_MERGED:
+		return "MERGED"
+	default:
+		return ""
+	}
+}
+
+func timeToPB(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func timeFromPB(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
@@ -5,41 +5,152 @@ import (
 	"errors"
 	"log/slog"
 	"net/http"
+	"net/url"
 
 	d "github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/delivery/dto"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/events"
 	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/repository"
 	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/usecase"
 )
 
 type Handler struct {
 	service usecase.PRService
+	events  *events.Hub
 	logger  *slog.Logger
 }
 
-func NewHandler(service usecase.PRService, logger *slog.Logger) *Handler {
-	return &Handler{service: service, logger: logger}
+func NewHandler(service usecase.PRService, hub *events.Hub, logger *slog.Logger) *Handler {
+	return &Handler{service: service, events: hub, logger: logger}
 }
 
 type errorResponse struct {
 	Error struct {
-		Code    string `json:"code"`
-		Message string `json:"message"`
+		Code    string         `json:"code"`
+		Message string         `json:"message"`
+		Details []d.FieldError `json:"details,omitempty"`
 	} `json:"error"`
 }
 
-func (h *Handler) sendError(w http.ResponseWriter, status int, code, msg string) {
+// WriteError writes an error in the common response format. It is exported
+// for middlewares that reject requests before they reach a handler.
+func WriteError(w http.ResponseWriter, status int, code, msg string) {
+	writeError(w, status, code, msg, nil)
+}
+
+func writeError(w http.ResponseWriter, status int, code, msg string, details []d.FieldError) {
 	resp := errorResponse{}
 	resp.Error.Code = code
 	resp.Error.Message = msg
+	resp.Error.Details = details
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(status)
 	_ = json.NewEncoder(w).Encode(resp)
 }
 
+func (h *Handler) sendError(w http.ResponseWriter, status int, code, msg string) {
+	writeError(w, status, code, msg, nil)
+}
+
+// sendUnexpected handles errors not matched by a handler: input rejected by
+// the service becomes 400, anything else is logged and reported as 500.
+func (h *Handler) sendUnexpected(w http.ResponseWriter, msg string, err error) {
+	var invalid *usecase.InvalidArgumentError
+	if errors.As(err, &invalid) {
+		h.sendError(w, http.StatusBadRequest, "INVALID_REQUEST", invalid.Msg)
+		return
+	}
+	h.logger.Error(msg, "err", err)
+	h.sendError(w, http.StatusInternalServerError, "INTERNAL", "internal error")
+}
+
+// rulesDetail returns the client-facing reason a pair rule cannot be met.
+func rulesDetail(err error) string {
+	var unsatisfiable *usecase.RulesUnsatisfiableError
+	if errors.As(err, &unsatisfiable) {
+		return unsatisfiable.Msg
+	}
+	return "pair rules cannot be satisfied"
+}
+
+// TeamResponse, PRResponse and the other response mappers are shared with
+// prctl's direct mode so both print what the API returns.
+func TeamResponse(team *models.Team) d.TeamResponse {
+	resp := d.TeamResponse{TeamName: team.Name, Members: []d.MemberDTO{}}
+	for _, m := range team.Members {
+		resp.Members = append(resp.Members, d.MemberDTO{
+			UserID:   m.ID,
+			Username: m.Username,
+			IsActive: m.IsActive,
+		})
+	}
+	return resp
+}
+
+func PRResponse(pr *models.PullRequest) d.PRResponse {
+	return d.PRResponse{
+		PullRequestID:     pr.ID,
+		PullRequestName:   pr.Name,
+		AuthorID:          pr.AuthorID,
+		Status:            pr.Status,
+		IsDraft:           pr.IsDraft,
+		Repository:        pr.Repository,
+		URL:               pr.URL,
+		Labels:            pr.Labels,
+		LinesAdded:        pr.LinesAdded,
+		LinesRemoved:      pr.LinesRemoved,
+		Description:       pr.Description,
+		AssignedReviewers: pr.AssignedReviewers,
+		ReviewerTags:      pr.ReviewerTags,
+		UncoveredTags:     pr.UncoveredTags,
+		Explanations:      explanationResponses(pr.Explanations),
+		PolicyViolations:  pr.PolicyViolations,
+		CreatedAt:         pr.CreatedAt,
+		MergedAt:          pr.MergedAt,
+	}
+}
+
+func PRShortResponse(pr *models.PRShort) d.PRShortResponse {
+	return d.PRShortResponse{
+		PullRequestID:   pr.ID,
+		PullRequestName: pr.Name,
+		AuthorID:        pr.AuthorID,
+		Status:          pr.Status,
+		Repository:      pr.Repository,
+		URL:             pr.URL,
+		Labels:          pr.Labels,
+		CreatedAt:       pr.CreatedAt,
+	}
+}
+
+func explanationResponses(explanations []models.ReviewerExplanation) []d.ExplanationResponse {
+	if len(explanations) == 0 {
+		return nil
+	}
+	out := make([]d.ExplanationResponse, 0, len(explanations))
+	for _, e := range explanations {
+		resp := d.ExplanationResponse{
+			ReviewerID:     e.ReviewerID,
+			Strategy:       e.Strategy,
+			PoolSize:       e.PoolSize,
+			Excluded:       []d.ExclusionResponse{},
+			MatchedTags:    e.MatchedTags,
+			InWorkingHours: e.InWorkingHours,
+			Score:          e.Score,
+			Seed:           e.Seed,
+			ChosenAt:       e.CreatedAt,
+		}
+		for _, x := range e.Excluded {
+			resp.Excluded = append(resp.Excluded, d.ExclusionResponse{UserID: x.UserID, Reason: x.Reason})
+		}
+		out = append(out, resp)
+	}
+	return out
+}
+
 func (h *Handler) AddTeam(w http.ResponseWriter, r *http.Request) {
 	var req d.TeamDTO
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.sendError(w, http.StatusBadRequest, "INVALID_REQUEST", "invalid json")
+	if !h.decode(w, r, &req) {
 		return
 	}
 
@@ -54,23 +165,15 @@ func (h *Handler) AddTeam(w http.ResponseWriter, r *http.Request) {
 
 	created, err := h.service.CreateTeam(r.Context(), team)
 	if err != nil {
-		if errors.Is(err, errors.New("TEAM_EXISTS")) {
+		if errors.Is(err, usecase.ErrTeamExists) {
 			h.sendError(w, http.StatusBadRequest, "TEAM_EXISTS", "team_name already exists")
 		} else {
-			h.logger.Error("create team failed", "err", err)
-			h.sendError(w, http.StatusInternalServerError, "INTERNAL", "internal error")
+			h.sendUnexpected(w, "create team failed", err)
 		}
 		return
 	}
 
-	resp := d.TeamResponse{TeamName: created.Name}
-	for _, m := range created.Members {
-		resp.Members = append(resp.Members, d.MemberDTO{
-			UserID:   m.ID,
-			Username: m.Username,
-			IsActive: m.IsActive,
-		})
-	}
+	resp := TeamResponse(created)
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusCreated)
@@ -86,23 +189,15 @@ func (h *Handler) GetTeam(w http.ResponseWriter, r *http.Request) {
 
 	team, err := h.service.GetTeam(r.Context(), teamName)
 	if err != nil {
-		if err.Error() == "team not found" {
+		if errors.Is(err, repository.ErrTeamNotFound) {
 			h.sendError(w, http.StatusNotFound, "NOT_FOUND", "team not found")
 		} else {
-			h.logger.Error("get team failed", "err", err)
-			h.sendError(w, http.StatusInternalServerError, "INTERNAL", "internal error")
+			h.sendUnexpected(w, "get team failed", err)
 		}
 		return
 	}
 
-	resp := d.TeamResponse{TeamName: team.Name}
-	for _, m := range team.Members {
-		resp.Members = append(resp.Members, d.MemberDTO{
-			UserID:   m.ID,
-			Username: m.Username,
-			IsActive: m.IsActive,
-		})
-	}
+	resp := TeamResponse(team)
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
@@ -111,18 +206,16 @@ func (h *Handler) GetTeam(w http.ResponseWriter, r *http.Request) {
 
 func (h *Handler) SetIsActive(w http.ResponseWriter, r *http.Request) {
 	var req d.UserActiveDTO
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.sendError(w, http.StatusBadRequest, "INVALID_REQUEST", "invalid json")
+	if !h.decode(w, r, &req) {
 		return
 	}
 
 	user, err := h.service.SetUserActive(r.Context(), req.UserID, req.IsActive)
 	if err != nil {
-		if err.Error() == "user not found" {
+		if errors.Is(err, repository.ErrUserNotFound) {
 			h.sendError(w, http.StatusNotFound, "NOT_FOUND", "user not found")
 		} else {
-			h.logger.Error("set active failed", "err", err)
-			h.sendError(w, http.StatusInternalServerError, "INTERNAL", "internal error")
+			h.sendUnexpected(w, "set active failed", err)
 		}
 		return
 	}
@@ -139,41 +232,65 @@ func (h *Handler) SetIsActive(w http.ResponseWriter, r *http.Request) {
 	_ = json.NewEncoder(w).Encode(map[string]any{"user": resp})
 }
 
+func (h *Handler) SetTags(w http.ResponseWriter, r *http.Request) {
+	var req d.UserTagsDTO
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	tags, err := h.service.SetUserTags(r.Context(), req.UserID, req.Tags)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			h.sendError(w, http.StatusNotFound, "NOT_FOUND", "user not found")
+		} else {
+			h.sendUnexpected(w, "set tags failed", err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(d.UserTagsResponse{UserID: req.UserID, Tags: tags})
+}
+
 func (h *Handler) CreatePR(w http.ResponseWriter, r *http.Request) {
 	var req d.PRCreateDTO
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.sendError(w, http.StatusBadRequest, "INVALID_REQUEST", "invalid json")
+	if !h.decode(w, r, &req) {
 		return
 	}
 
 	pr := models.PullRequest{
-		ID:       req.PullRequestID,
-		Name:     req.PullRequestName,
-		AuthorID: req.AuthorID,
+		ID:           req.PullRequestID,
+		Name:         req.PullRequestName,
+		AuthorID:     req.AuthorID,
+		ChangedFiles: req.ChangedFiles,
+		RequiredTags: req.RequiredTags,
+		IsDraft:      req.IsDraft,
+		Repository:   req.Repository,
+		URL:          req.URL,
+		Labels:       req.Labels,
+		LinesAdded:   req.LinesAdded,
+		LinesRemoved: req.LinesRemoved,
+		Description:  req.Description,
 	}
 
 	created, err := h.service.CreatePR(r.Context(), pr)
 	if err != nil {
-		if errors.Is(err, errors.New("PR_EXISTS")) {
+		if errors.Is(err, usecase.ErrPRExists) {
 			h.sendError(w, http.StatusConflict, "PR_EXISTS", "PR id already exists")
-		} else if err.Error() == "user not found" {
+		} else if errors.Is(err, repository.ErrUserNotFound) || errors.Is(err, repository.ErrUserNoTeam) {
 			h.sendError(w, http.StatusNotFound, "NOT_FOUND", "author not found")
+		} else if errors.Is(err, repository.ErrRepositoryNotFound) {
+			h.sendError(w, http.StatusNotFound, "NOT_FOUND", "repository not found")
+		} else if errors.Is(err, usecase.ErrRulesUnsatisfiable) {
+			h.sendError(w, http.StatusConflict, "RULES_UNSATISFIABLE", rulesDetail(err))
 		} else {
-			h.logger.Error("create pr failed", "err", err)
-			h.sendError(w, http.StatusInternalServerError, "INTERNAL", "internal error")
+			h.sendUnexpected(w, "create pr failed", err)
 		}
 		return
 	}
 
-	resp := d.PRResponse{
-		PullRequestID:     created.ID,
-		PullRequestName:   created.Name,
-		AuthorID:          created.AuthorID,
-		Status:            created.Status,
-		AssignedReviewers: created.AssignedReviewers,
-		CreatedAt:         created.CreatedAt,
-		MergedAt:          created.MergedAt,
-	}
+	resp := PRResponse(created)
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusCreated)
@@ -182,32 +299,52 @@ func (h *Handler) CreatePR(w http.ResponseWriter, r *http.Request) {
 
 func (h *Handler) MergePR(w http.ResponseWriter, r *http.Request) {
 	var req d.PRMergeDTO
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.sendError(w, http.StatusBadRequest, "INVALID_REQUEST", "invalid json")
+	if !h.decode(w, r, &req) {
 		return
 	}
 
 	pr, err := h.service.MergePR(r.Context(), req.PullRequestID)
 	if err != nil {
-		if err.Error() == "pr not found" {
+		if errors.Is(err, repository.ErrPRNotFound) {
 			h.sendError(w, http.StatusNotFound, "NOT_FOUND", "PR not found")
 		} else {
-			h.logger.Error("merge pr failed", "err", err)
-			h.sendError(w, http.StatusInternalServerError, "INTERNAL", "internal error")
+			h.sendUnexpected(w, "merge pr failed", err)
 		}
 		return
 	}
 
-	resp := d.PRResponse{
-		PullRequestID:     pr.ID,
-		PullRequestName:   pr.Name,
-		AuthorID:          pr.AuthorID,
-		Status:            pr.Status,
-		AssignedReviewers: pr.AssignedReviewers,
-		CreatedAt:         pr.CreatedAt,
-		MergedAt:          pr.MergedAt,
+	resp := PRResponse(pr)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(map[string]any{"pr": resp})
+}
+
+func (h *Handler) ReadyPR(w http.ResponseWriter, r *http.Request) {
+	var req d.PRReadyDTO
+	if !h.decode(w, r, &req) {
+		return
 	}
 
+	pr, err := h.service.ReadyPR(r.Context(), req.PullRequestID)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrPRNotFound):
+			h.sendError(w, http.StatusNotFound, "NOT_FOUND", "PR not found")
+		case errors.Is(err, repository.ErrUserNotFound), errors.Is(err, repository.ErrUserNoTeam):
+			h.sendError(w, http.StatusNotFound, "NOT_FOUND", "author not found")
+		case errors.Is(err, usecase.ErrPRMerged):
+			h.sendError(w, http.StatusConflict, "PR_MERGED", "PR is already merged")
+		case errors.Is(err, usecase.ErrRulesUnsatisfiable):
+			h.sendError(w, http.StatusConflict, "RULES_UNSATISFIABLE", rulesDetail(err))
+		default:
+			h.sendUnexpected(w, "ready pr failed", err)
+		}
+		return
+	}
+
+	resp := PRResponse(pr)
+
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	_ = json.NewEncoder(w).Encode(map[string]any{"pr": resp})
@@ -215,35 +352,30 @@ func (h *Handler) MergePR(w http.ResponseWriter, r *http.Request) {
 
 func (h *Handler) Reassign(w http.ResponseWriter, r *http.Request) {
 	var req d.PRReassignDTO
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.sendError(w, http.StatusBadRequest, "INVALID_REQUEST", "invalid json")
+	if !h.decode(w, r, &req) {
 		return
 	}
 
 	pr, replacedBy, err := h.service.ReassignReviewer(r.Context(), req.PullRequestID, req.OldUserID)
 	if err != nil {
 		switch {
-		case errors.Is(err, errors.New("PR_MERGED")):
+		case errors.Is(err, repository.ErrPRNotFound):
+			h.sendError(w, http.StatusNotFound, "NOT_FOUND", "PR not found")
+		case errors.Is(err, usecase.ErrPRMerged):
 			h.sendError(w, http.StatusConflict, "PR_MERGED", "cannot reassign on merged PR")
-		case errors.Is(err, errors.New("NOT_ASSIGNED")):
+		case errors.Is(err, usecase.ErrNotAssigned):
 			h.sendError(w, http.StatusConflict, "NOT_ASSIGNED", "reviewer is not assigned to this PR")
-		case err.Error() == "no candidate":
+		case errors.Is(err, repository.ErrNoCandidate):
 			h.sendError(w, http.StatusConflict, "NO_CANDIDATE", "no active replacement candidate in team")
+		case errors.Is(err, usecase.ErrRulesUnsatisfiable):
+			h.sendError(w, http.StatusConflict, "RULES_UNSATISFIABLE", rulesDetail(err))
 		default:
-			h.sendError(w, http.StatusInternalServerError, "INTERNAL", "internal error")
+			h.sendUnexpected(w, "reassign failed", err)
 		}
 		return
 	}
 
-	resp := d.PRResponse{
-		PullRequestID:     pr.ID,
-		PullRequestName:   pr.Name,
-		AuthorID:          pr.AuthorID,
-		Status:            pr.Status,
-		AssignedReviewers: pr.AssignedReviewers,
-		CreatedAt:         pr.CreatedAt,
-		MergedAt:          pr.MergedAt,
-	}
+	resp := PRResponse(pr)
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
@@ -251,34 +383,65 @@ func (h *Handler) Reassign(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *Handler) GetReviews(w http.ResponseWriter, r *http.Request) {
-	userID := r.URL.Query().Get("user_id")
+	q := r.URL.Query()
+	userID := q.Get("user_id")
 	if userID == "" {
 		h.sendError(w, http.StatusBadRequest, "INVALID_REQUEST", "user_id required")
 		return
 	}
 
-	prs, err := h.service.GetUserReviews(r.Context(), userID)
+	filter, err := parseReviewFilter(q)
 	if err != nil {
-		if err.Error() == "user not found" {
+		h.sendError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+
+	prs, next, err := h.service.GetUserReviews(r.Context(), userID, filter)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrUserNotFound):
 			h.sendError(w, http.StatusNotFound, "NOT_FOUND", "user not found")
-		} else {
-			h.logger.Error("get reviews failed", "err", err)
-			h.sendError(w, http.StatusInternalServerError, "INTERNAL", "internal error")
+		case errors.Is(err, usecase.ErrInvalidFilter):
+			h.sendError(w, http.StatusBadRequest, "INVALID_REQUEST", "invalid filter")
+		default:
+			h.sendUnexpected(w, "get reviews failed", err)
 		}
 		return
 	}
 
-	resp := d.UserReviewsResponse{UserID: userID}
+	resp := d.UserReviewsResponse{UserID: userID, PullRequests: []d.PRShortResponse{}, NextCursor: next.Encode()}
 	for _, pr := range prs {
-		resp.PullRequests = append(resp.PullRequests, d.PRShortResponse{
-			PullRequestID:   pr.ID,
-			PullRequestName: pr.Name,
-			AuthorID:        pr.AuthorID,
-			Status:          pr.Status,
-		})
+		resp.PullRequests = append(resp.PullRequests, PRShortResponse(&pr))
 	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	_ = json.NewEncoder(w).Encode(resp)
 }
+
+func parseReviewFilter(q url.Values) (models.ReviewFilter, error) {
+	var f models.ReviewFilter
+	var err error
+	if f.Status, err = parseStatusParam(q); err != nil {
+		return f, err
+	}
+	f.AuthorID = q.Get("author_id")
+	f.Repository = q.Get("repository")
+	f.Label = q.Get("label")
+	if f.CreatedAfter, err = parseTimeParam(q, "created_after"); err != nil {
+		return f, err
+	}
+	if f.CreatedBefore, err = parseTimeParam(q, "created_before"); err != nil {
+		return f, err
+	}
+	if f.Ascending, err = parseSortParam(q); err != nil {
+		return f, err
+	}
+	if f.Limit, err = parseLimitParam(q); err != nil {
+		return f, err
+	}
+	if f.After, err = models.DecodeCursor(q.Get("cursor")); err != nil {
+		return f, err
+	}
+	return f, nil
+}
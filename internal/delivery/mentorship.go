@@ -0,0 +1,50 @@
+package delivery
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	d "github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/delivery/dto"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/repository"
+)
+
+func (h *Handler) SetSeniority(w http.ResponseWriter, r *http.Request) {
+	var req d.UserSeniorityDTO
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	if err := h.service.SetUserSeniority(r.Context(), req.UserID, req.Seniority); err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			h.sendError(w, http.StatusNotFound, "NOT_FOUND", "user not found")
+		} else {
+			h.sendUnexpected(w, "set seniority failed", err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(req)
+}
+
+func (h *Handler) SetTeamMentorship(w http.ResponseWriter, r *http.Request) {
+	var req d.TeamMentorshipDTO
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	if err := h.service.SetTeamMentorship(r.Context(), req.TeamName, req.Enabled); err != nil {
+		if errors.Is(err, repository.ErrTeamNotFound) {
+			h.sendError(w, http.StatusNotFound, "NOT_FOUND", "team not found")
+		} else {
+			h.sendUnexpected(w, "set team mentorship failed", err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(req)
+}
@@ -0,0 +1,92 @@
+package delivery
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+
+	d "github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/delivery/dto"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/repository"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/usecase"
+)
+
+func (h *Handler) GetPR(w http.ResponseWriter, r *http.Request) {
+	prID := r.URL.Query().Get("pull_request_id")
+	if prID == "" {
+		h.sendError(w, http.StatusBadRequest, "INVALID_REQUEST", "pull_request_id required")
+		return
+	}
+
+	pr, err := h.service.GetPR(r.Context(), prID)
+	if err != nil {
+		if errors.Is(err, repository.ErrPRNotFound) {
+			h.sendError(w, http.StatusNotFound, "NOT_FOUND", "PR not found")
+		} else {
+			h.sendUnexpected(w, "get pr failed", err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(map[string]any{"pr": PRResponse(pr)})
+}
+
+func (h *Handler) ListPRs(w http.ResponseWriter, r *http.Request) {
+	filter, err := parsePRFilter(r.URL.Query())
+	if err != nil {
+		h.sendError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+
+	prs, next, err := h.service.ListPRs(r.Context(), filter)
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidFilter) {
+			h.sendError(w, http.StatusBadRequest, "INVALID_REQUEST", "invalid filter")
+		} else {
+			h.sendUnexpected(w, "list prs failed", err)
+		}
+		return
+	}
+
+	resp := d.PRListResponse{PullRequests: []d.PRResponse{}, NextCursor: next.Encode()}
+	for i := range prs {
+		resp.PullRequests = append(resp.PullRequests, PRResponse(&prs[i]))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func parsePRFilter(q url.Values) (models.PRFilter, error) {
+	f := models.PRFilter{
+		TeamName:     q.Get("team_name"),
+		AuthorID:     q.Get("author_id"),
+		Repository:   q.Get("repository"),
+		ReviewerID:   q.Get("reviewer_id"),
+		NameContains: q.Get("name"),
+	}
+	var err error
+	if f.Status, err = parseStatusParam(q); err != nil {
+		return f, err
+	}
+	if f.CreatedAfter, err = parseTimeParam(q, "created_after"); err != nil {
+		return f, err
+	}
+	if f.CreatedBefore, err = parseTimeParam(q, "created_before"); err != nil {
+		return f, err
+	}
+	if f.Ascending, err = parseSortParam(q); err != nil {
+		return f, err
+	}
+	if f.Limit, err = parseLimitParam(q); err != nil {
+		return f, err
+	}
+	if f.After, err = models.DecodeCursor(q.Get("cursor")); err != nil {
+		return f, err
+	}
+	return f, nil
+}
@@ -0,0 +1,30 @@
+package delivery
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	d "github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/delivery/dto"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/repository"
+)
+
+func (h *Handler) SetTeamLargePRs(w http.ResponseWriter, r *http.Request) {
+	var req d.TeamLargePRsDTO
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	if err := h.service.SetTeamLargePRLines(r.Context(), req.TeamName, req.MinLines); err != nil {
+		if errors.Is(err, repository.ErrTeamNotFound) {
+			h.sendError(w, http.StatusNotFound, "NOT_FOUND", "team not found")
+		} else {
+			h.sendUnexpected(w, "set team large prs failed", err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(req)
+}
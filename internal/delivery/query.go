@@ -0,0 +1,67 @@
+package delivery
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+func parseBoolParam(q url.Values, name string) (*bool, error) {
+	v := q.Get(name)
+	if v == "" {
+		return nil, nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be true or false", name)
+	}
+	return &b, nil
+}
+
+func parseTimeParam(q url.Values, name string) (*time.Time, error) {
+	v := q.Get(name)
+	if v == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be an RFC 3339 timestamp", name)
+	}
+	return &t, nil
+}
+
+func parseLimitParam(q url.Values) (int, error) {
+	v := q.Get("limit")
+	if v == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n <= 0 {
+		return 0, errors.New("limit must be a positive integer")
+	}
+	return n, nil
+}
+
+// parseSortParam accepts created_at_desc (default) and created_at_asc and
+// reports whether the order is ascending.
+func parseSortParam(q url.Values) (bool, error) {
+	switch q.Get("sort") {
+	case "", "created_at_desc":
+		return false, nil
+	case "created_at_asc":
+		return true, nil
+	default:
+		return false, errors.New("sort must be created_at_asc or created_at_desc")
+	}
+}
+
+func parseStatusParam(q url.Values) (string, error) {
+	switch s := q.Get("status"); s {
+	case "", "OPEN", "MERGED":
+		return s, nil
+	default:
+		return "", errors.New("status must be OPEN or MERGED")
+	}
+}
@@ -0,0 +1,49 @@
+package delivery
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	d "github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/delivery/dto"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/repository"
+)
+
+func (h *Handler) SetRepository(w http.ResponseWriter, r *http.Request) {
+	var req d.RepositoryDTO
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	repo, err := h.service.SetRepository(r.Context(), models.Repository{Name: req.Repository, TeamName: req.TeamName})
+	if err != nil {
+		if errors.Is(err, repository.ErrTeamNotFound) {
+			h.sendError(w, http.StatusNotFound, "NOT_FOUND", "team not found")
+		} else {
+			h.sendUnexpected(w, "set repository failed", err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(d.RepositoryDTO{Repository: repo.Name, TeamName: repo.TeamName})
+}
+
+func (h *Handler) ListRepositories(w http.ResponseWriter, r *http.Request) {
+	repos, err := h.service.ListRepositories(r.Context())
+	if err != nil {
+		h.sendUnexpected(w, "list repositories failed", err)
+		return
+	}
+
+	resp := d.RepositoryListResponse{Repositories: []d.RepositoryDTO{}}
+	for _, repo := range repos {
+		resp.Repositories = append(resp.Repositories, d.RepositoryDTO{Repository: repo.Name, TeamName: repo.TeamName})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(resp)
+}
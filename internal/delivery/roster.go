@@ -0,0 +1,150 @@
+package delivery
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	d "github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/delivery/dto"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/repository"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/roster"
+)
+
+// ImportTeams applies a YAML or CSV roster. The format comes from the format
+// query parameter or the Content-Type; dry_run=true only reports the diff.
+func (h *Handler) ImportTeams(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	formatName := q.Get("format")
+	if formatName == "" {
+		formatName = r.Header.Get("Content-Type")
+	}
+	format, err := roster.ParseFormat(formatName)
+	if err != nil {
+		h.sendError(w, http.StatusUnsupportedMediaType, "INVALID_REQUEST", "body must be yaml or csv")
+		return
+	}
+	dryRun := false
+	if v := q.Get("dry_run"); v != "" {
+		if dryRun, err = strconv.ParseBool(v); err != nil {
+			h.sendError(w, http.StatusBadRequest, "INVALID_REQUEST", "dry_run must be true or false")
+			return
+		}
+	}
+
+	teams, err := roster.Decode(http.MaxBytesReader(w, r.Body, maxBodyBytes), format)
+	if err != nil {
+		var perr *roster.ParseError
+		var tooLarge *http.MaxBytesError
+		switch {
+		case errors.As(err, &tooLarge):
+			h.sendError(w, http.StatusRequestEntityTooLarge, "INVALID_REQUEST", "request body too large")
+		case errors.As(err, &perr):
+			h.sendError(w, http.StatusBadRequest, "INVALID_IMPORT", perr.Error())
+		default:
+			h.sendUnexpected(w, "read import failed", err)
+		}
+		return
+	}
+
+	var verr *d.ValidationError
+	if errors.As(ValidateRoster(teams), &verr) {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "validation failed", verr.Fields)
+		return
+	}
+
+	res, err := h.service.ImportTeams(r.Context(), teams, dryRun)
+	if err != nil {
+		h.sendUnexpected(w, "import teams failed", err)
+		return
+	}
+
+	resp := TeamImportResponse(res, dryRun)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// ExportTeams writes all teams, or the one named by team_name, as YAML
+// (default) or CSV.
+func (h *Handler) ExportTeams(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	format := roster.FormatYAML
+	if v := q.Get("format"); v != "" {
+		var err error
+		if format, err = roster.ParseFormat(v); err != nil {
+			h.sendError(w, http.StatusBadRequest, "INVALID_REQUEST", "format must be yaml or csv")
+			return
+		}
+	}
+
+	teams, err := h.service.ExportTeams(r.Context(), q.Get("team_name"))
+	if err != nil {
+		if errors.Is(err, repository.ErrTeamNotFound) {
+			h.sendError(w, http.StatusNotFound, "NOT_FOUND", "team not found")
+		} else {
+			h.sendUnexpected(w, "export teams failed", err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="teams.%s"`, format))
+	w.WriteHeader(http.StatusOK)
+	if err := roster.Encode(w, format, teams); err != nil {
+		h.logger.Error("write export failed", "err", err)
+	}
+}
+
+// ValidateRoster checks decoded teams with the same rules as TeamDTO. Field
+// names are prefixed with the team's position, as in teams[1].members[0].
+func ValidateRoster(teams []models.Team) error {
+	var fields []d.FieldError
+	for i, t := range teams {
+		dto := d.TeamDTO{TeamName: t.Name}
+		for _, m := range t.Members {
+			dto.Members = append(dto.Members, d.MemberDTO{UserID: m.ID, Username: m.Username, IsActive: m.IsActive})
+		}
+		var verr *d.ValidationError
+		if errors.As(dto.Validate(), &verr) {
+			for _, f := range verr.Fields {
+				fields = append(fields, d.FieldError{Field: fmt.Sprintf("teams[%d].%s", i, f.Field), Reason: f.Reason})
+			}
+		}
+	}
+	if len(fields) > 0 {
+		return &d.ValidationError{Fields: fields}
+	}
+	return nil
+}
+
+func TeamImportResponse(res *models.TeamImport, dryRun bool) d.TeamImportResponse {
+	resp := d.TeamImportResponse{
+		DryRun:       dryRun,
+		Applied:      res.Applied,
+		CreatedTeams: res.CreatedTeams,
+		Added:        UserResponses(res.Added),
+		Updated:      UserResponses(res.Updated),
+		Moved:        []d.UserMoveDiff{},
+		Deactivated:  UserResponses(res.Deactivated),
+		Unchanged:    res.Unchanged,
+	}
+	if resp.CreatedTeams == nil {
+		resp.CreatedTeams = []string{}
+	}
+	for _, m := range res.Moved {
+		resp.Moved = append(resp.Moved, d.UserMoveDiff{UserResponse: UserResponse(&m.User), FromTeam: m.FromTeam})
+	}
+	return resp
+}
+
+func UserResponses(users []models.User) []d.UserResponse {
+	resp := make([]d.UserResponse, 0, len(users))
+	for i := range users {
+		resp = append(resp, UserResponse(&users[i]))
+	}
+	return resp
+}
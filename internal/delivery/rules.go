@@ -0,0 +1,77 @@
+package delivery
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	d "github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/delivery/dto"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/repository"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/usecase"
+)
+
+func TeamRulesResponse(teamName string, rules []models.PairRule) d.TeamRulesResponse {
+	resp := d.TeamRulesResponse{TeamName: teamName, Rules: []d.PairRuleDTO{}}
+	for _, r := range rules {
+		resp.Rules = append(resp.Rules, d.PairRuleDTO{Type: r.Kind, ReviewerID: r.ReviewerID, AuthorID: r.AuthorID})
+	}
+	return resp
+}
+
+// PairRules converts requested rules to the model, for the handler and
+// prctl's direct mode alike.
+func PairRules(rules []d.PairRuleDTO) []models.PairRule {
+	out := make([]models.PairRule, 0, len(rules))
+	for _, rule := range rules {
+		out = append(out, models.PairRule{Kind: rule.Type, ReviewerID: rule.ReviewerID, AuthorID: rule.AuthorID})
+	}
+	return out
+}
+
+func (h *Handler) SetTeamRules(w http.ResponseWriter, r *http.Request) {
+	var req d.TeamRulesDTO
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	stored, err := h.service.SetPairRules(r.Context(), req.TeamName, PairRules(req.Rules))
+	if err != nil {
+		var notMember *usecase.NotTeamMemberError
+		switch {
+		case errors.Is(err, repository.ErrTeamNotFound):
+			h.sendError(w, http.StatusNotFound, "NOT_FOUND", "team not found")
+		case errors.As(err, &notMember):
+			h.sendError(w, http.StatusNotFound, "NOT_FOUND", "user is not a member of the team: "+notMember.UserID)
+		default:
+			h.sendUnexpected(w, "set team rules failed", err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(TeamRulesResponse(req.TeamName, stored))
+}
+
+func (h *Handler) GetTeamRules(w http.ResponseWriter, r *http.Request) {
+	teamName := r.URL.Query().Get("team_name")
+	if teamName == "" {
+		h.sendError(w, http.StatusBadRequest, "INVALID_REQUEST", "team_name required")
+		return
+	}
+
+	rules, err := h.service.GetPairRules(r.Context(), teamName)
+	if err != nil {
+		if errors.Is(err, repository.ErrTeamNotFound) {
+			h.sendError(w, http.StatusNotFound, "NOT_FOUND", "team not found")
+		} else {
+			h.sendUnexpected(w, "get team rules failed", err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(TeamRulesResponse(teamName, rules))
+}
@@ -0,0 +1,77 @@
+package delivery
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	d "github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/delivery/dto"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/repository"
+)
+
+var defaultWorkDays = []string{"mon", "tue", "wed", "thu", "fri"}
+
+// scheduleModel converts a validated schedule.
+func scheduleModel(s *d.ScheduleDTO) *models.WorkSchedule {
+	if s == nil {
+		return nil
+	}
+	loc, _ := time.LoadLocation(s.Timezone)
+	start, _ := d.ParseClock(s.Start)
+	end, _ := d.ParseClock(s.End)
+	out := &models.WorkSchedule{Location: loc, Start: start, End: end}
+	days := s.Days
+	if len(days) == 0 {
+		days = defaultWorkDays
+	}
+	for _, name := range days {
+		day, _ := d.ParseWeekday(name)
+		out.Days[day] = true
+	}
+	return out
+}
+
+func scheduleResponse(s *models.WorkSchedule) *d.ScheduleDTO {
+	if s == nil {
+		return nil
+	}
+	resp := &d.ScheduleDTO{
+		Timezone: s.Location.String(),
+		Start:    fmt.Sprintf("%02d:%02d", s.Start/60, s.Start%60),
+		End:      fmt.Sprintf("%02d:%02d", s.End/60, s.End%60),
+		Days:     []string{},
+	}
+	// Listed from Monday, the way people read a working week.
+	for i := 1; i <= 7; i++ {
+		day := time.Weekday(i % 7)
+		if s.Days[day] {
+			resp.Days = append(resp.Days, strings.ToLower(day.String()[:3]))
+		}
+	}
+	return resp
+}
+
+func (h *Handler) SetSchedule(w http.ResponseWriter, r *http.Request) {
+	var req d.UserScheduleDTO
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	schedule := scheduleModel(req.Schedule)
+	if err := h.service.SetUserSchedule(r.Context(), req.UserID, schedule); err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			h.sendError(w, http.StatusNotFound, "NOT_FOUND", "user not found")
+		} else {
+			h.sendUnexpected(w, "set schedule failed", err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(d.UserScheduleResponse{UserID: req.UserID, Schedule: scheduleResponse(schedule)})
+}
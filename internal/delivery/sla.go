@@ -0,0 +1,118 @@
+package delivery
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	d "github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/delivery/dto"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/repository"
+)
+
+func teamSLAResponse(sla *models.TeamSLA) d.TeamSLAResponse {
+	resp := d.TeamSLAResponse{
+		TeamName:  sla.TeamName,
+		ReviewSLA: sla.ReviewSLA.String(),
+		IsDefault: sla.IsDefault,
+	}
+	if sla.ReassignAfter > 0 {
+		resp.ReassignAfter = sla.ReassignAfter.String()
+	}
+	return resp
+}
+
+func (h *Handler) SetTeamSLA(w http.ResponseWriter, r *http.Request) {
+	var req d.TeamSLADTO
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	// Both durations were checked by Validate.
+	sla := models.TeamSLA{TeamName: req.TeamName}
+	sla.ReviewSLA, _ = time.ParseDuration(req.ReviewSLA)
+	if req.ReassignAfter != "" {
+		sla.ReassignAfter, _ = time.ParseDuration(req.ReassignAfter)
+	}
+
+	stored, err := h.service.SetTeamSLA(r.Context(), sla)
+	if err != nil {
+		if errors.Is(err, repository.ErrTeamNotFound) {
+			h.sendError(w, http.StatusNotFound, "NOT_FOUND", "team not found")
+		} else {
+			h.sendUnexpected(w, "set team sla failed", err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(map[string]any{"sla": teamSLAResponse(stored)})
+}
+
+func (h *Handler) GetTeamSLA(w http.ResponseWriter, r *http.Request) {
+	teamName := r.URL.Query().Get("team_name")
+	if teamName == "" {
+		h.sendError(w, http.StatusBadRequest, "INVALID_REQUEST", "team_name required")
+		return
+	}
+
+	sla, err := h.service.GetTeamSLA(r.Context(), teamName)
+	if err != nil {
+		if errors.Is(err, repository.ErrTeamNotFound) {
+			h.sendError(w, http.StatusNotFound, "NOT_FOUND", "team not found")
+		} else {
+			h.sendUnexpected(w, "get team sla failed", err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(map[string]any{"sla": teamSLAResponse(sla)})
+}
+
+func (h *Handler) ListOverdue(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	limit, err := parseLimitParam(q)
+	if err != nil {
+		h.sendError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+	filter := models.OverdueFilter{
+		TeamName:   q.Get("team_name"),
+		ReviewerID: q.Get("reviewer_id"),
+		Limit:      limit,
+	}
+
+	overdue, err := h.service.ListOverdue(r.Context(), filter)
+	if err != nil {
+		if errors.Is(err, repository.ErrTeamNotFound) {
+			h.sendError(w, http.StatusNotFound, "NOT_FOUND", "team not found")
+		} else {
+			h.sendUnexpected(w, "list overdue failed", err)
+		}
+		return
+	}
+
+	resp := d.OverdueListResponse{Reviews: []d.OverdueReviewResponse{}}
+	for _, o := range overdue {
+		resp.Reviews = append(resp.Reviews, d.OverdueReviewResponse{
+			PullRequestID:   o.PullRequestID,
+			PullRequestName: o.PullRequestName,
+			AuthorID:        o.AuthorID,
+			TeamName:        o.TeamName,
+			ReviewerID:      o.ReviewerID,
+			AssignedAt:      o.AssignedAt,
+			DueAt:           o.DueAt,
+			OverdueSeconds:  int64(o.OverdueBy / time.Second),
+			RemindedAt:      o.RemindedAt,
+			ReassignAt:      o.ReassignAt,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,86 @@
+package delivery
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+
+	d "github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/delivery/dto"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/repository"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/usecase"
+)
+
+func round(v float64, places int) float64 {
+	p := math.Pow(10, float64(places))
+	return math.Round(v*p) / p
+}
+
+func memberFairnessResponses(members []models.MemberFairness) []d.MemberFairnessResponse {
+	out := make([]d.MemberFairnessResponse, 0, len(members))
+	for _, m := range members {
+		out = append(out, d.MemberFairnessResponse{
+			UserID:     m.UserID,
+			Username:   m.Username,
+			Reviews:    m.Reviews,
+			ActiveDays: round(m.ActiveDays, 2),
+			Expected:   round(m.Expected, 2),
+			Deviation:  round(m.Deviation, 2),
+		})
+	}
+	return out
+}
+
+func (h *Handler) GetFairness(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	filter := models.FairnessFilter{TeamName: q.Get("team_name")}
+	if filter.TeamName == "" {
+		h.sendError(w, http.StatusBadRequest, "INVALID_REQUEST", "team_name required")
+		return
+	}
+	from, err := parseTimeParam(q, "from")
+	if err != nil {
+		h.sendError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+	to, err := parseTimeParam(q, "to")
+	if err != nil {
+		h.sendError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+	if from != nil {
+		filter.From = *from
+	}
+	if to != nil {
+		filter.To = *to
+	}
+
+	report, err := h.service.GetFairness(r.Context(), filter)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrTeamNotFound):
+			h.sendError(w, http.StatusNotFound, "NOT_FOUND", "team not found")
+		case errors.Is(err, usecase.ErrInvalidFilter):
+			h.sendError(w, http.StatusBadRequest, "INVALID_REQUEST", "from must be before to")
+		default:
+			h.sendUnexpected(w, "get fairness failed", err)
+		}
+		return
+	}
+
+	resp := d.FairnessResponse{
+		TeamName:      report.TeamName,
+		From:          report.From,
+		To:            report.To,
+		TotalReviews:  report.TotalReviews,
+		Gini:          round(report.Gini, 3),
+		Members:       memberFairnessResponses(report.Members),
+		OverAssigned:  memberFairnessResponses(report.OverAssigned),
+		UnderAssigned: memberFairnessResponses(report.UnderAssigned),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(resp)
+}
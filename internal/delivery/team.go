@@ -0,0 +1,143 @@
+package delivery
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	d "github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/delivery/dto"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/repository"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/usecase"
+)
+
+func (h *Handler) UpdateTeam(w http.ResponseWriter, r *http.Request) {
+	var req d.TeamUpdateDTO
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	team := models.Team{Name: req.TeamName}
+	for _, m := range req.Members {
+		team.Members = append(team.Members, models.Member{
+			ID:       m.UserID,
+			Username: m.Username,
+			IsActive: m.IsActive,
+		})
+	}
+
+	updated, err := h.service.UpdateTeam(r.Context(), team, models.OpenPRPolicy(req.OpenPRsPolicy))
+	if err != nil {
+		h.sendTeamChangeError(w, "update team failed", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(map[string]any{"team": TeamResponse(updated)})
+}
+
+func (h *Handler) RemoveTeamMember(w http.ResponseWriter, r *http.Request) {
+	var req d.TeamMemberRemoveDTO
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	team, err := h.service.RemoveTeamMember(r.Context(), req.TeamName, req.UserID, models.OpenPRPolicy(req.OpenPRsPolicy))
+	if err != nil {
+		h.sendTeamChangeError(w, "remove member failed", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(map[string]any{"team": TeamResponse(team)})
+}
+
+func (h *Handler) DeleteTeam(w http.ResponseWriter, r *http.Request) {
+	teamName := r.URL.Query().Get("team_name")
+	if teamName == "" {
+		h.sendError(w, http.StatusBadRequest, "INVALID_REQUEST", "team_name required")
+		return
+	}
+	policy := models.OpenPRPolicy(r.URL.Query().Get("open_prs_policy"))
+
+	removed, err := h.service.DeleteTeam(r.Context(), teamName, policy)
+	if err != nil {
+		h.sendTeamChangeError(w, "delete team failed", err)
+		return
+	}
+	if removed == nil {
+		removed = []string{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(d.TeamDeleteResponse{TeamName: teamName, RemovedMembers: removed})
+}
+
+func (h *Handler) sendTeamChangeError(w http.ResponseWriter, msg string, err error) {
+	var openErr *usecase.OpenPRsError
+	switch {
+	case errors.As(err, &openErr):
+		h.sendError(w, http.StatusConflict, "OPEN_PRS", openErr.Error())
+	case errors.Is(err, repository.ErrTeamNotFound):
+		h.sendError(w, http.StatusNotFound, "NOT_FOUND", "team not found")
+	case errors.Is(err, usecase.ErrNotTeamMember):
+		h.sendError(w, http.StatusNotFound, "NOT_FOUND", "user is not a member of the team")
+	case errors.Is(err, usecase.ErrInvalidPolicy):
+		h.sendError(w, http.StatusBadRequest, "INVALID_REQUEST", "open_prs_policy must be refuse or reassign")
+	case errors.Is(err, usecase.ErrPRMerged), errors.Is(err, usecase.ErrNotAssigned):
+		h.sendError(w, http.StatusConflict, "PR_CHANGED", "a pull request changed during the update, retry")
+	default:
+		h.sendUnexpected(w, msg, err)
+	}
+}
+
+func (h *Handler) MoveUser(w http.ResponseWriter, r *http.Request) {
+	var req d.UserMoveDTO
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	user, handover, err := h.service.MoveUser(r.Context(), req.UserID, req.TeamName, models.MovePolicy(req.ReviewPolicy))
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrUserNotFound):
+			h.sendError(w, http.StatusNotFound, "NOT_FOUND", "user not found")
+		case errors.Is(err, repository.ErrTeamNotFound):
+			h.sendError(w, http.StatusNotFound, "NOT_FOUND", "team not found")
+		case errors.Is(err, usecase.ErrAlreadyInTeam):
+			h.sendError(w, http.StatusConflict, "ALREADY_IN_TEAM", "user is already a member of the team")
+		case errors.Is(err, usecase.ErrInvalidPolicy):
+			h.sendError(w, http.StatusBadRequest, "INVALID_REQUEST", "review_policy must be keep or reassign")
+		case errors.Is(err, usecase.ErrPRMerged), errors.Is(err, usecase.ErrNotAssigned):
+			h.sendError(w, http.StatusConflict, "PR_CHANGED", "a pull request changed during the move, retry")
+		default:
+			h.sendUnexpected(w, "move user failed", err)
+		}
+		return
+	}
+	reassigned, removed := handover.Reassigned, handover.Removed
+	if reassigned == nil {
+		reassigned = []string{}
+	}
+	if removed == nil {
+		removed = []string{}
+	}
+
+	resp := d.UserMoveResponse{
+		User: d.UserResponse{
+			UserID:   user.ID,
+			Username: user.Username,
+			TeamName: user.TeamName,
+			IsActive: user.IsActive,
+		},
+		ReassignedPRs: reassigned,
+		RemovedPRs:    removed,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,104 @@
+package delivery
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+
+	d "github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/delivery/dto"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/repository"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/usecase"
+)
+
+func UserResponse(u *models.User) d.UserResponse {
+	return d.UserResponse{
+		UserID:   u.ID,
+		Username: u.Username,
+		TeamName: u.TeamName,
+		IsActive: u.IsActive,
+	}
+}
+
+func UserDetailsResponse(u *models.UserDetails) d.UserDetailsResponse {
+	return d.UserDetailsResponse{
+		UserResponse:    UserResponse(&u.User),
+		Tags:            u.Tags,
+		Seniority:       u.Seniority,
+		IsAvailable:     u.IsAvailable(),
+		OpenReviewCount: u.OpenReviewCount,
+		AuthoredOpenPRs: u.AuthoredOpenPRs,
+		Schedule:        scheduleResponse(u.Schedule),
+		InWorkingHours:  u.InWorkingHours,
+	}
+}
+
+func (h *Handler) GetUser(w http.ResponseWriter, r *http.Request) {
+	userID := r.URL.Query().Get("user_id")
+	if userID == "" {
+		h.sendError(w, http.StatusBadRequest, "INVALID_REQUEST", "user_id required")
+		return
+	}
+
+	user, err := h.service.GetUser(r.Context(), userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			h.sendError(w, http.StatusNotFound, "NOT_FOUND", "user not found")
+		} else {
+			h.sendUnexpected(w, "get user failed", err)
+		}
+		return
+	}
+
+	resp := UserDetailsResponse(user)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(map[string]any{"user": resp})
+}
+
+func (h *Handler) ListUsers(w http.ResponseWriter, r *http.Request) {
+	filter, err := parseUserFilter(r.URL.Query())
+	if err != nil {
+		h.sendError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+
+	users, next, err := h.service.ListUsers(r.Context(), filter)
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidFilter) {
+			h.sendError(w, http.StatusBadRequest, "INVALID_REQUEST", "invalid filter")
+		} else {
+			h.sendUnexpected(w, "list users failed", err)
+		}
+		return
+	}
+
+	resp := d.UserListResponse{Users: []d.UserResponse{}, NextCursor: models.EncodeIDCursor(next)}
+	for i := range users {
+		resp.Users = append(resp.Users, UserResponse(&users[i]))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func parseUserFilter(q url.Values) (models.UserFilter, error) {
+	f := models.UserFilter{
+		TeamName:       q.Get("team_name"),
+		UsernamePrefix: q.Get("username_prefix"),
+	}
+	var err error
+	if f.IsActive, err = parseBoolParam(q, "is_active"); err != nil {
+		return f, err
+	}
+	if f.Limit, err = parseLimitParam(q); err != nil {
+		return f, err
+	}
+	if f.AfterID, err = models.DecodeIDCursor(q.Get("cursor")); err != nil {
+		return f, err
+	}
+	return f, nil
+}
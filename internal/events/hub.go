@@ -0,0 +1,153 @@
+// Package events fans assignment events out to stream subscribers. Events
+// are persisted through the repository, delivered to local subscribers right
+// away and relayed to other replicas via Postgres LISTEN/NOTIFY.
+package events
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/repository"
+)
+
+// subscriberBuffer is how many events a subscriber may fall behind before it
+// is dropped; a dropped client reconnects and resumes from the log.
+const subscriberBuffer = 64
+
+type Hub struct {
+	repo   repository.EventRepository
+	origin string
+	logger *slog.Logger
+
+	mu   sync.Mutex
+	subs map[*Subscription]struct{}
+}
+
+func NewHub(repo repository.EventRepository, logger *slog.Logger) *Hub {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return &Hub{
+		repo:   repo,
+		origin: hex.EncodeToString(b),
+		logger: logger,
+		subs:   make(map[*Subscription]struct{}),
+	}
+}
+
+// Subscription receives live events matching its filter on C. C is closed
+// when the subscription is cancelled or falls too far behind.
+type Subscription struct {
+	C      <-chan models.Event
+	ch     chan models.Event
+	filter models.EventFilter
+	hub    *Hub
+}
+
+func (s *Subscription) Close() {
+	s.hub.remove(s)
+}
+
+func (h *Hub) Subscribe(filter models.EventFilter) *Subscription {
+	ch := make(chan models.Event, subscriberBuffer)
+	sub := &Subscription{C: ch, ch: ch, filter: filter, hub: h}
+	h.mu.Lock()
+	h.subs[sub] = struct{}{}
+	h.mu.Unlock()
+	return sub
+}
+
+func (h *Hub) remove(sub *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.subs[sub]; ok {
+		delete(h.subs, sub)
+		close(sub.ch)
+	}
+}
+
+// Publish persists the events and delivers them to local subscribers.
+func (h *Hub) Publish(ctx context.Context, events []models.Event) error {
+	stored, err := h.repo.Append(ctx, h.origin, events)
+	if err != nil {
+		return err
+	}
+	h.deliver(stored)
+	return nil
+}
+
+// Replay returns logged events after filter.AfterID, oldest first.
+func (h *Hub) Replay(ctx context.Context, filter models.EventFilter) ([]models.Event, error) {
+	return h.repo.List(ctx, filter)
+}
+
+func (h *Hub) deliver(events []models.Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, ev := range events {
+		for sub := range h.subs {
+			if !sub.filter.Match(ev) {
+				continue
+			}
+			select {
+			case sub.ch <- ev:
+			default:
+				h.logger.Warn("dropping slow event subscriber", "user", sub.filter.UserID, "team", sub.filter.TeamName)
+				delete(h.subs, sub)
+				close(sub.ch)
+			}
+		}
+	}
+}
+
+// Run relays events published by other replicas to local subscribers until
+// ctx is done, reconnecting after failures.
+func (h *Hub) Run(ctx context.Context) {
+	backoff := time.Second
+	for {
+		start := time.Now()
+		err := h.repo.Listen(ctx, func(origin string, ev models.Event) {
+			if origin != h.origin {
+				h.deliver([]models.Event{ev})
+			}
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		if time.Since(start) > time.Minute {
+			backoff = time.Second
+		}
+		h.logger.Error("event listener stopped", "err", err, "retry_in", backoff)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, 30*time.Second)
+	}
+}
+
+// RunCleanup periodically removes logged events older than retention.
+func (h *Hub) RunCleanup(ctx context.Context, interval, retention time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := h.repo.DeleteBefore(ctx, time.Now().Add(-retention))
+			if err != nil {
+				h.logger.Error("event cleanup failed", "err", err)
+				continue
+			}
+			if n > 0 {
+				h.logger.Info("old events removed", "count", n)
+			}
+		}
+	}
+}
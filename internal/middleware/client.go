@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+)
+
+type clientContextKey struct{}
+
+// WithAuthenticatedClient records the verified identity of the caller. An
+// authentication layer in front of the other middleware sets it once a
+// credential has been checked; until then callers are told apart by IP.
+func WithAuthenticatedClient(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, clientContextKey{}, id)
+}
+
+// AuthenticatedClient returns the identity set by WithAuthenticatedClient.
+func AuthenticatedClient(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(clientContextKey{}).(string)
+	return id, ok && id != ""
+}
+
+// clientKey identifies the caller by its authenticated identity, otherwise by
+// remote IP. Request headers such as Authorization are never trusted on their
+// own, since any client can send them. X-Forwarded-For is only honoured when
+// trustForwardedFor is set.
+func clientKey(r *http.Request, trustForwardedFor bool) string {
+	if id, ok := AuthenticatedClient(r.Context()); ok {
+		return "client:" + id
+	}
+	if trustForwardedFor {
+		if xff := r.Header.Get("X-Forwarded-For"); xff != "" {
+			ip, _, _ := strings.Cut(xff, ",")
+			return "ip:" + strings.TrimSpace(ip)
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return "ip:" + host
+}
@@ -0,0 +1,177 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/delivery"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/repository"
+)
+
+const (
+	IdempotencyKeyHeader = "Idempotency-Key"
+
+	maxIdempotencyKeyLen = 255
+	maxIdempotentBody    = 1 << 20
+)
+
+// Idempotency replays stored responses for POST requests retried with the
+// same Idempotency-Key, so retries never repeat side effects such as picking
+// another random reviewer. Keys are scoped per client, identified the same
+// way as by the rate limiter.
+type Idempotency struct {
+	repo              repository.IdempotencyRepository
+	ttl               time.Duration
+	trustForwardedFor bool
+	logger            *slog.Logger
+}
+
+func NewIdempotency(repo repository.IdempotencyRepository, ttl time.Duration, trustForwardedFor bool, logger *slog.Logger) *Idempotency {
+	return &Idempotency{repo: repo, ttl: ttl, trustForwardedFor: trustForwardedFor, logger: logger}
+}
+
+func (m *Idempotency) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(IdempotencyKeyHeader)
+		if r.Method != http.MethodPost || key == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if len(key) > maxIdempotencyKeyLen {
+			delivery.WriteError(w, http.StatusBadRequest, "INVALID_REQUEST", "idempotency key too long")
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxIdempotentBody+1))
+		if err != nil {
+			delivery.WriteError(w, http.StatusBadRequest, "INVALID_REQUEST", "cannot read body")
+			return
+		}
+		if len(body) > maxIdempotentBody {
+			delivery.WriteError(w, http.StatusRequestEntityTooLarge, "INVALID_REQUEST", "body too large")
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		rec := models.IdempotencyRecord{
+			Client:      clientKey(r, m.trustForwardedFor),
+			Key:         key,
+			Route:       r.URL.Path,
+			RequestHash: requestHash(r, body),
+			ExpiresAt:   time.Now().Add(m.ttl),
+		}
+
+		existing, reserved, err := m.repo.Reserve(r.Context(), rec)
+		if err != nil {
+			m.logger.Error("reserve idempotency key failed", "err", err)
+			delivery.WriteError(w, http.StatusInternalServerError, "INTERNAL", "internal error")
+			return
+		}
+		if !reserved {
+			m.replay(w, rec, existing)
+			return
+		}
+
+		rw := &recorder{ResponseWriter: w, status: http.StatusOK}
+		defer func() {
+			// Requests are released on failure or panic so that a retry can run
+			// them again; anything below 500 is final and gets stored.
+			ctx := context.WithoutCancel(r.Context())
+			if p := recover(); p != nil {
+				_ = m.repo.Release(ctx, rec)
+				panic(p)
+			}
+			if rw.status >= http.StatusInternalServerError {
+				if err := m.repo.Release(ctx, rec); err != nil {
+					m.logger.Error("release idempotency key failed", "err", err)
+				}
+				return
+			}
+			if err := m.repo.Complete(ctx, rec, rw.status, rw.body.Bytes()); err != nil {
+				m.logger.Error("store idempotent response failed", "err", err)
+			}
+		}()
+		next.ServeHTTP(rw, r)
+	})
+}
+
+// requestHash covers the query string as well as the body: flags such as
+// dry_run change what a request does.
+func requestHash(r *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(r.URL.Query().Encode()))
+	h.Write([]byte{0})
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (m *Idempotency) replay(w http.ResponseWriter, rec models.IdempotencyRecord, existing *models.IdempotencyRecord) {
+	switch {
+	case existing.RequestHash != rec.RequestHash:
+		delivery.WriteError(w, http.StatusUnprocessableEntity, "IDEMPOTENCY_KEY_REUSED",
+			"idempotency key was already used with a different request")
+	case existing.StatusCode == 0:
+		delivery.WriteError(w, http.StatusConflict, "IDEMPOTENCY_IN_PROGRESS",
+			"a request with this idempotency key is still being processed")
+	default:
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Idempotent-Replayed", "true")
+		w.WriteHeader(existing.StatusCode)
+		_, _ = w.Write(existing.Body)
+	}
+}
+
+// RunCleanup deletes expired keys every interval until ctx is done.
+func (m *Idempotency) RunCleanup(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := m.repo.DeleteExpired(ctx)
+			if err != nil {
+				m.logger.Error("idempotency cleanup failed", "err", err)
+				continue
+			}
+			if n > 0 {
+				m.logger.Info("expired idempotency keys deleted", "count", n)
+			}
+		}
+	}
+}
+
+// recorder passes the response through while keeping a copy of it.
+type recorder struct {
+	http.ResponseWriter
+	status      int
+	body        bytes.Buffer
+	wroteHeader bool
+}
+
+func (rw *recorder) WriteHeader(status int) {
+	if !rw.wroteHeader {
+		rw.status = status
+		rw.wroteHeader = true
+	}
+	rw.ResponseWriter.WriteHeader(status)
+}
+
+func (rw *recorder) Write(b []byte) (int, error) {
+	rw.wroteHeader = true
+	rw.body.Write(b)
+	return rw.ResponseWriter.Write(b)
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer.
+func (rw *recorder) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
@@ -0,0 +1,226 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/delivery"
+)
+
+// DefaultRateLimits is used when no configuration is given: generous overall,
+// strict on reassignment to stop reviewer roulette.
+const DefaultRateLimits = "default=50/s:100,/pullRequest/reassign=10/m:5"
+
+// Limit is a token bucket: Rate tokens are added per second up to Burst.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+type RateLimitResult struct {
+	Allowed    bool
+	Remaining  int
+	Reset      time.Duration
+	RetryAfter time.Duration
+}
+
+// RateLimitStore keeps bucket state. MemoryStore is process-local; a shared
+// backend lets several replicas enforce one budget.
+type RateLimitStore interface {
+	Take(ctx context.Context, key string, limit Limit) (RateLimitResult, error)
+}
+
+type RateLimitConfig struct {
+	Default Limit
+	Routes  map[string]Limit
+	// TrustForwardedFor takes the client IP from X-Forwarded-For; enable it
+	// only behind a proxy that sets the header.
+	TrustForwardedFor bool
+}
+
+// ParseRateLimits reads comma-separated "route=rate:burst" entries, where
+// route is a path template or "default" and rate is "N/s", "N/m" or "N/h".
+func ParseRateLimits(s string) (RateLimitConfig, error) {
+	cfg := RateLimitConfig{Routes: make(map[string]Limit)}
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		route, spec, ok := strings.Cut(entry, "=")
+		if !ok {
+			return cfg, fmt.Errorf("rate limit %q: expected route=rate:burst", entry)
+		}
+		limit, err := parseLimit(spec)
+		if err != nil {
+			return cfg, fmt.Errorf("rate limit %q: %w", entry, err)
+		}
+		if route == "default" {
+			cfg.Default = limit
+		} else {
+			cfg.Routes[route] = limit
+		}
+	}
+	return cfg, nil
+}
+
+func parseLimit(spec string) (Limit, error) {
+	rate, burst, ok := strings.Cut(spec, ":")
+	if !ok {
+		return Limit{}, fmt.Errorf("missing burst")
+	}
+	n, unit, ok := strings.Cut(rate, "/")
+	if !ok {
+		return Limit{}, fmt.Errorf("rate must look like N/s, N/m or N/h")
+	}
+	count, err := strconv.ParseFloat(n, 64)
+	if err != nil || count <= 0 {
+		return Limit{}, fmt.Errorf("invalid rate %q", n)
+	}
+	var per time.Duration
+	switch unit {
+	case "s":
+		per = time.Second
+	case "m":
+		per = time.Minute
+	case "h":
+		per = time.Hour
+	default:
+		return Limit{}, fmt.Errorf("unknown rate unit %q", unit)
+	}
+	b, err := strconv.Atoi(burst)
+	if err != nil || b <= 0 {
+		return Limit{}, fmt.Errorf("invalid burst %q", burst)
+	}
+	return Limit{Rate: count / per.Seconds(), Burst: b}, nil
+}
+
+type RateLimiter struct {
+	store  RateLimitStore
+	cfg    RateLimitConfig
+	logger *slog.Logger
+}
+
+func NewRateLimiter(store RateLimitStore, cfg RateLimitConfig, logger *slog.Logger) *RateLimiter {
+	return &RateLimiter{store: store, cfg: cfg, logger: logger}
+}
+
+func (l *RateLimiter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := r.URL.Path
+		if cur := mux.CurrentRoute(r); cur != nil {
+			if tpl, err := cur.GetPathTemplate(); err == nil {
+				route = tpl
+			}
+		}
+		limit, ok := l.cfg.Routes[route]
+		if !ok {
+			limit = l.cfg.Default
+		}
+		if limit.Burst == 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		res, err := l.store.Take(r.Context(), clientKey(r, l.cfg.TrustForwardedFor)+"|"+route, limit)
+		if err != nil {
+			// Fail open: an unavailable limiter backend must not take the API down.
+			l.logger.Error("rate limit store failed", "err", err)
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		h := w.Header()
+		h.Set("RateLimit-Limit", strconv.Itoa(limit.Burst))
+		h.Set("RateLimit-Remaining", strconv.Itoa(res.Remaining))
+		h.Set("RateLimit-Reset", strconv.Itoa(ceilSeconds(res.Reset)))
+		if !res.Allowed {
+			h.Set("Retry-After", strconv.Itoa(ceilSeconds(res.RetryAfter)))
+			delivery.WriteError(w, http.StatusTooManyRequests, "RATE_LIMITED", "too many requests")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func ceilSeconds(d time.Duration) int {
+	if d <= 0 {
+		return 0
+	}
+	return int(math.Ceil(d.Seconds()))
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+	// refill is how long the bucket takes to fill up from empty; after that
+	// long idle it is indistinguishable from a new one.
+	refill time.Duration
+}
+
+// MemoryStore is an in-process token bucket store.
+type MemoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{buckets: make(map[string]*bucket), now: time.Now}
+}
+
+func (s *MemoryStore) Take(_ context.Context, key string, limit Limit) (RateLimitResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.sweep(now)
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Burst), last: now}
+		s.buckets[key] = b
+	}
+	b.refill = secondsToDuration(float64(limit.Burst) / limit.Rate)
+	b.tokens = math.Min(float64(limit.Burst), b.tokens+now.Sub(b.last).Seconds()*limit.Rate)
+	b.last = now
+
+	var res RateLimitResult
+	if b.tokens >= 1 {
+		b.tokens--
+		res.Allowed = true
+	} else {
+		res.RetryAfter = secondsToDuration((1 - b.tokens) / limit.Rate)
+	}
+	res.Remaining = int(b.tokens)
+	res.Reset = secondsToDuration((float64(limit.Burst) - b.tokens) / limit.Rate)
+	return res, nil
+}
+
+// sweep drops buckets idle for longer than their refill period, at most once
+// a minute. Dropping one earlier would hand a throttled client a full burst.
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < time.Minute {
+		return
+	}
+	s.lastSweep = now
+	for k, b := range s.buckets {
+		if now.Sub(b.last) > b.refill {
+			delete(s.buckets, k)
+		}
+	}
+}
+
+func secondsToDuration(sec float64) time.Duration {
+	return time.Duration(sec * float64(time.Second))
+}
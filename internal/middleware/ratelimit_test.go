@@ -0,0 +1,224 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+type fakeClock struct{ t time.Time }
+
+func (c *fakeClock) now() time.Time { return c.t }
+
+func newTestStore() (*MemoryStore, *fakeClock) {
+	clock := &fakeClock{t: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	store := NewMemoryStore()
+	store.now = clock.now
+	return store, clock
+}
+
+func TestMemoryStoreRefill(t *testing.T) {
+	store, clock := newTestStore()
+	limit := Limit{Rate: 1, Burst: 2}
+	ctx := context.Background()
+
+	steps := []struct {
+		advance   time.Duration
+		allowed   bool
+		remaining int
+	}{
+		{0, true, 1},
+		{0, true, 0},
+		{0, false, 0},
+		// Half a token is not enough to pass.
+		{500 * time.Millisecond, false, 0},
+		{500 * time.Millisecond, true, 0},
+		// A long idle refills only up to the burst.
+		{time.Hour, true, 1},
+		{0, true, 0},
+		{0, false, 0},
+	}
+	for i, step := range steps {
+		clock.t = clock.t.Add(step.advance)
+		res, err := store.Take(ctx, "k", limit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Allowed != step.allowed || res.Remaining != step.remaining {
+			t.Errorf("step %d: allowed=%v remaining=%d, want %v %d", i, res.Allowed, res.Remaining, step.allowed, step.remaining)
+		}
+	}
+}
+
+func TestParseRateLimits(t *testing.T) {
+	cfg, err := ParseRateLimits(DefaultRateLimits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Default != (Limit{Rate: 50, Burst: 100}) {
+		t.Errorf("default = %+v", cfg.Default)
+	}
+	if got := cfg.Routes["/pullRequest/reassign"]; got != (Limit{Rate: 10.0 / 60, Burst: 5}) {
+		t.Errorf("reassign = %+v", got)
+	}
+
+	for _, bad := range []string{"default", "default=5/s", "default=5:1", "default=0/s:1", "default=5/d:1", "default=5/s:0"} {
+		if _, err := ParseRateLimits(bad); err == nil {
+			t.Errorf("%q: expected an error", bad)
+		}
+	}
+}
+
+// newLimitedRouter routes /a and /b through a limiter allowing one request
+// per minute by default and two on /b.
+func newLimitedRouter(store RateLimitStore, trustForwardedFor bool) http.Handler {
+	cfg := RateLimitConfig{
+		Default:           Limit{Rate: 1.0 / 60, Burst: 1},
+		Routes:            map[string]Limit{"/b": {Rate: 2.0 / 60, Burst: 2}},
+		TrustForwardedFor: trustForwardedFor,
+	}
+	limiter := NewRateLimiter(store, cfg, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	r := mux.NewRouter()
+	ok := func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) }
+	r.HandleFunc("/a", ok)
+	r.HandleFunc("/b", ok)
+	r.Use(limiter.Middleware)
+	return r
+}
+
+func TestRateLimiterRoutesAndKeys(t *testing.T) {
+	type call struct {
+		path     string
+		remote   string
+		client   string
+		xff      string
+		wantCode int
+	}
+	tests := []struct {
+		name              string
+		trustForwardedFor bool
+		calls             []call
+	}{
+		{"per-route limits", false, []call{
+			{path: "/a", remote: "10.0.0.1:1", wantCode: http.StatusOK},
+			{path: "/a", remote: "10.0.0.1:1", wantCode: http.StatusTooManyRequests},
+			{path: "/b", remote: "10.0.0.1:1", wantCode: http.StatusOK},
+			{path: "/b", remote: "10.0.0.1:1", wantCode: http.StatusOK},
+			{path: "/b", remote: "10.0.0.1:1", wantCode: http.StatusTooManyRequests},
+		}},
+		{"remote ip ignores port", false, []call{
+			{path: "/a", remote: "10.0.0.1:1", wantCode: http.StatusOK},
+			{path: "/a", remote: "10.0.0.1:2", wantCode: http.StatusTooManyRequests},
+			{path: "/a", remote: "10.0.0.2:1", wantCode: http.StatusOK},
+		}},
+		{"identity across ips", false, []call{
+			{path: "/a", remote: "10.0.0.1:1", client: "alice", wantCode: http.StatusOK},
+			{path: "/a", remote: "10.0.0.2:1", client: "alice", wantCode: http.StatusTooManyRequests},
+			{path: "/a", remote: "10.0.0.1:1", client: "bob", wantCode: http.StatusOK},
+			{path: "/a", remote: "10.0.0.1:1", wantCode: http.StatusOK},
+		}},
+		{"forwarded for untrusted", false, []call{
+			{path: "/a", remote: "10.0.0.1:1", xff: "1.1.1.1", wantCode: http.StatusOK},
+			{path: "/a", remote: "10.0.0.1:1", xff: "2.2.2.2", wantCode: http.StatusTooManyRequests},
+		}},
+		{"forwarded for trusted", true, []call{
+			{path: "/a", remote: "10.0.0.1:1", xff: "1.1.1.1, 10.0.0.9", wantCode: http.StatusOK},
+			{path: "/a", remote: "10.0.0.1:1", xff: "2.2.2.2", wantCode: http.StatusOK},
+			{path: "/a", remote: "10.0.0.3:1", xff: "1.1.1.1", wantCode: http.StatusTooManyRequests},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store, _ := newTestStore()
+			h := newLimitedRouter(store, tt.trustForwardedFor)
+			for i, c := range tt.calls {
+				req := httptest.NewRequest(http.MethodGet, c.path, nil)
+				req.RemoteAddr = c.remote
+				if c.xff != "" {
+					req.Header.Set("X-Forwarded-For", c.xff)
+				}
+				if c.client != "" {
+					req = req.WithContext(WithAuthenticatedClient(req.Context(), c.client))
+				}
+				rec := httptest.NewRecorder()
+				h.ServeHTTP(rec, req)
+				if rec.Code != c.wantCode {
+					t.Errorf("call %d %s: status %d, want %d", i, c.path, rec.Code, c.wantCode)
+				}
+			}
+		})
+	}
+}
+
+func TestRateLimiterResponse(t *testing.T) {
+	store, clock := newTestStore()
+	h := newLimitedRouter(store, false)
+	do := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/b", nil)
+		req.RemoteAddr = "10.0.0.1:1"
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := do()
+	wantHeaders := map[string]string{"RateLimit-Limit": "2", "RateLimit-Remaining": "1", "RateLimit-Reset": "30", "Retry-After": ""}
+	for k, want := range wantHeaders {
+		if got := rec.Header().Get(k); got != want {
+			t.Errorf("allowed %s = %q, want %q", k, got, want)
+		}
+	}
+
+	do()
+	clock.t = clock.t.Add(10 * time.Second)
+	rec = do()
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("status %d, want 429", rec.Code)
+	}
+	// Two requests a minute: the next token is 20s away, the bucket full in 50s.
+	wantHeaders = map[string]string{"RateLimit-Limit": "2", "RateLimit-Remaining": "0", "RateLimit-Reset": "50", "Retry-After": "20"}
+	for k, want := range wantHeaders {
+		if got := rec.Header().Get(k); got != want {
+			t.Errorf("limited %s = %q, want %q", k, got, want)
+		}
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("content type %q", ct)
+	}
+	var body struct {
+		Error struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+		t.Fatal(err)
+	}
+	if body.Error.Code != "RATE_LIMITED" || body.Error.Message == "" {
+		t.Errorf("body = %+v", body)
+	}
+}
+
+type failingStore struct{}
+
+func (failingStore) Take(context.Context, string, Limit) (RateLimitResult, error) {
+	return RateLimitResult{}, io.ErrUnexpectedEOF
+}
+
+func TestRateLimiterFailsOpen(t *testing.T) {
+	h := newLimitedRouter(failingStore{}, false)
+	for range 3 {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/a", nil))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("status %d, want 200", rec.Code)
+		}
+	}
+}
@@ -1,10 +1,19 @@
 package models
 
-import "time"
+import (
+	"regexp"
+	"time"
+)
 
 type Team struct {
 	Name    string
 	Members []Member
+	// Mentorship requires a senior reviewer on every pull request and pairs
+	// them with a junior when possible.
+	Mentorship bool
+	// LargePRLines is the number of changed lines from which a pull request
+	// gets an extra reviewer; zero turns this off.
+	LargePRLines int
 }
 
 type Member struct {
@@ -13,6 +22,32 @@ type Member struct {
 	IsActive bool
 }
 
+// OpenPRPolicy decides what happens to open pull requests of users that
+// leave a team.
+type OpenPRPolicy string
+
+const (
+	OpenPRPolicyRefuse   OpenPRPolicy = "refuse"
+	OpenPRPolicyReassign OpenPRPolicy = "reassign"
+)
+
+// MovePolicy decides what happens to open reviews of a user moving to
+// another team.
+type MovePolicy string
+
+const (
+	MovePolicyKeep     MovePolicy = "keep"
+	MovePolicyReassign MovePolicy = "reassign"
+)
+
+var idPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._:-]*$`)
+
+// ValidID reports whether s may be used as a user or pull request id. '#' is
+// excluded since it separates the repository in pull request keys.
+func ValidID(s string) bool {
+	return idPattern.MatchString(s)
+}
+
 type User struct {
 	ID       string
 	Username string
@@ -20,19 +55,336 @@ type User struct {
 	IsActive bool
 }
 
+// UserDetails is a user together with their current workload.
+type UserDetails struct {
+	User
+	Tags            []string
+	Seniority       string
+	OpenReviewCount int
+	AuthoredOpenPRs []string
+	Schedule        *WorkSchedule
+	InWorkingHours  bool
+}
+
+// IsAvailable reports whether the user can currently be picked as a reviewer.
+func (u UserDetails) IsAvailable() bool {
+	return u.IsActive && u.TeamName != ""
+}
+
+// UserMove is a user's new state together with the team they left; FromTeam
+// is empty for users that had no team.
+type UserMove struct {
+	User
+	FromTeam string
+}
+
+// ReviewHandover lists the open pull requests a user stopped reviewing when
+// leaving a team: those handed over to another reviewer and those left
+// without a replacement.
+type ReviewHandover struct {
+	Reassigned []string
+	Removed    []string
+}
+
+// TeamImport describes the changes an import makes, or would make on a dry
+// run. Users are listed with their state after the import.
+type TeamImport struct {
+	CreatedTeams []string
+	Added        []User
+	Updated      []User
+	Moved        []UserMove
+	Deactivated  []User
+	Unchanged    int
+	Applied      bool
+}
+
+type UserFilter struct {
+	TeamName       string
+	IsActive       *bool
+	UsernamePrefix string
+	Limit          int
+	AfterID        string
+}
+
 type PullRequest struct {
 	ID                string
 	Name              string
 	AuthorID          string
 	Status            string
 	AssignedReviewers []string
+	IsDraft           bool
+	ChangedFiles      []string
+	RequiredTags      []string
+	Repository        string
+	URL               string
+	Labels            []string
+	LinesAdded        int
+	LinesRemoved      int
+	Description       string
+	ReviewerTags      map[string][]string
+	UncoveredTags     []string
+	Explanations      []ReviewerExplanation
+	PolicyViolations  []string
 	CreatedAt         *time.Time
 	MergedAt          *time.Time
 }
 
+// Strategies by which a reviewer is picked.
+const (
+	StrategyRequiredTags = "required_tags"
+	StrategyCodeOwner    = "code_owner"
+	StrategyRandom       = "random"
+	StrategyReplacement  = "replacement"
+	StrategyPairRule     = "pair_rule"
+	StrategySenior       = "mentorship_senior"
+	StrategyJunior       = "mentorship_junior"
+)
+
+// Seniority levels of users.
+const (
+	SeniorityJunior = "junior"
+	SeniorityMiddle = "middle"
+	SenioritySenior = "senior"
+)
+
+// ViolationNoSenior reports a pull request in a mentorship team left without
+// a senior reviewer because none was available.
+const ViolationNoSenior = "no_senior_reviewer"
+
+// Reasons a team member was not a candidate.
+const (
+	ExclusionAuthor      = "author"
+	ExclusionInactive    = "inactive"
+	ExclusionReplaced    = "replaced"
+	ExclusionAssigned    = "already_assigned"
+	ExclusionUnavailable = "unavailable"
+	ExclusionPairRule    = "pair_rule"
+)
+
+// Kinds of pair rules.
+const (
+	PairNever  = "never"
+	PairAlways = "always"
+)
+
+// PairRule keeps ReviewerID off pull requests by AuthorID (PairNever) or puts
+// them on every one (PairAlways).
+type PairRule struct {
+	Kind       string
+	ReviewerID string
+	AuthorID   string
+}
+
+type Exclusion struct {
+	UserID string
+	Reason string
+}
+
+// ReviewerExplanation records why a reviewer was picked. PoolSize counts the
+// candidates left after exclusions. Score ranks candidates the way selection
+// does: 100 per required tag covered, 10 for a code owner and 1 for being
+// within working hours.
+type ReviewerExplanation struct {
+	ReviewerID     string
+	Strategy       string
+	PoolSize       int
+	Excluded       []Exclusion
+	MatchedTags    []string
+	InWorkingHours bool
+	Score          int
+	Seed           int64
+	CreatedAt      time.Time
+}
+
 type PRShort struct {
-	ID       string
-	Name     string
-	AuthorID string
-	Status   string
+	ID         string
+	Name       string
+	AuthorID   string
+	Status     string
+	Repository string
+	URL        string
+	Labels     []string
+	CreatedAt  *time.Time
+}
+
+// Cursor is a keyset pagination position: the sort key of the last item of
+// the previous page.
+type Cursor struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+type ReviewFilter struct {
+	Status        string
+	AuthorID      string
+	Repository    string
+	Label         string
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	Ascending     bool
+	Limit         int
+	After         *Cursor
+}
+
+type PRFilter struct {
+	TeamName      string
+	AuthorID      string
+	Repository    string
+	ReviewerID    string
+	Status        string
+	NameContains  string
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	Ascending     bool
+	Limit         int
+	After         *Cursor
+}
+
+// IdempotencyRecord is a stored outcome of a request made with an
+// Idempotency-Key. Keys are scoped to the client that sent them. StatusCode is
+// zero while the request is still in flight.
+type IdempotencyRecord struct {
+	Client      string
+	Key         string
+	Route       string
+	RequestHash string
+	StatusCode  int
+	Body        []byte
+	ExpiresAt   time.Time
+}
+
+// Event types published when review assignments change.
+const (
+	EventReviewAssigned   = "review_assigned"
+	EventReviewUnassigned = "review_unassigned"
+	EventPRMerged         = "pr_merged"
+	EventReviewOverdue    = "review_overdue"
+)
+
+// Event is an entry of the assignment event log. UserID is the reviewer the
+// event concerns; TeamName is the team of the pull request author and
+// ReviewTeam the team reviewing it, both at the time the event was recorded.
+type Event struct {
+	ID            int64
+	Type          string
+	UserID        string
+	PullRequestID string
+	AuthorID      string
+	TeamName      string
+	ReviewTeam    string
+	CreatedAt     time.Time
+}
+
+type EventFilter struct {
+	UserID   string
+	TeamName string
+	AfterID  int64
+	Limit    int
+}
+
+// Match reports whether the event passes the filter's user and team
+// constraints. A team matches as the author's or the reviewing team.
+func (f EventFilter) Match(ev Event) bool {
+	if f.UserID != "" && ev.UserID != f.UserID {
+		return false
+	}
+	if f.TeamName != "" && ev.TeamName != f.TeamName && ev.ReviewTeam != f.TeamName {
+		return false
+	}
+	return true
+}
+
+// DefaultReviewSLA applies to teams without their own settings.
+const DefaultReviewSLA = 24 * time.Hour
+
+// TeamSLA is how long a reviewer has for a review before being reminded and,
+// when ReassignAfter is set, before the review is handed to someone else.
+// Both are measured from the assignment in the reviewer's working hours.
+type TeamSLA struct {
+	TeamName      string
+	ReviewSLA     time.Duration
+	ReassignAfter time.Duration
+	IsDefault     bool
+}
+
+// ReviewAssignment is an open assignment of a reviewer to a pull request.
+// TeamName is the reviewing team: the repository owner or, without one, the
+// author's team. SLA is nil when that team has no settings of its own, and
+// ReviewerSchedule is nil when the reviewer has no working hours set.
+type ReviewAssignment struct {
+	ID               int64
+	PullRequestID    string
+	PullRequestName  string
+	AuthorID         string
+	TeamName         string
+	ReviewerID       string
+	AssignedAt       time.Time
+	RemindedAt       *time.Time
+	EscalatedAt      *time.Time
+	SLA              *TeamSLA
+	ReviewerSchedule *WorkSchedule
+}
+
+// OverdueReview is an assignment past its SLA. ReassignAt is nil when the
+// team does not reassign overdue reviews.
+type OverdueReview struct {
+	ReviewAssignment
+	DueAt      time.Time
+	OverdueBy  time.Duration
+	ReassignAt *time.Time
+}
+
+type OverdueFilter struct {
+	TeamName   string
+	ReviewerID string
+	Limit      int
+}
+
+// Reassignment is an overdue review handed to another reviewer.
+type Reassignment struct {
+	PullRequestID string
+	FromUserID    string
+	ToUserID      string
+}
+
+// SLARun is the outcome of one pass of the SLA scheduler.
+type SLARun struct {
+	Reminded   []OverdueReview
+	Reassigned []Reassignment
+	Failed     int
+}
+
+type FairnessFilter struct {
+	TeamName string
+	From     time.Time
+	To       time.Time
+}
+
+// MemberLoad is how many reviews a user was assigned while in a team and how
+// long they were active in it within a period.
+type MemberLoad struct {
+	UserID     string
+	Username   string
+	Reviews    int
+	ActiveTime time.Duration
+}
+
+// MemberFairness compares a member's reviews with their share of the team's
+// reviews in proportion to active time.
+type MemberFairness struct {
+	MemberLoad
+	ActiveDays float64
+	Expected   float64
+	Deviation  float64
+}
+
+type FairnessReport struct {
+	TeamName      string
+	From          time.Time
+	To            time.Time
+	TotalReviews  int
+	Gini          float64
+	Members       []MemberFairness
+	OverAssigned  []MemberFairness
+	UnderAssigned []MemberFairness
 }
@@ -0,0 +1,25 @@
+package models
+
+import "testing"
+
+func TestEventFilterMatch(t *testing.T) {
+	// A backend author's pull request in a repository owned by platform.
+	ev := Event{UserID: "u1", TeamName: "backend", ReviewTeam: "platform"}
+	tests := []struct {
+		filter EventFilter
+		want   bool
+	}{
+		{EventFilter{UserID: "u1"}, true},
+		{EventFilter{UserID: "u2"}, false},
+		{EventFilter{TeamName: "backend"}, true},
+		{EventFilter{TeamName: "platform"}, true},
+		{EventFilter{TeamName: "frontend"}, false},
+		{EventFilter{UserID: "u1", TeamName: "platform"}, true},
+		{EventFilter{UserID: "u2", TeamName: "platform"}, false},
+	}
+	for _, tt := range tests {
+		if got := tt.filter.Match(ev); got != tt.want {
+			t.Errorf("%+v: Match = %v, want %v", tt.filter, got, tt.want)
+		}
+	}
+}
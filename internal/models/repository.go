@@ -0,0 +1,20 @@
+package models
+
+// DefaultRepository holds pull requests created without a repository. Its
+// pull request ids are not namespaced.
+const DefaultRepository = "default"
+
+// Repository is a code repository. Pull requests in it are reviewed by
+// TeamName or, when that is empty, by the author's team.
+type Repository struct {
+	Name     string
+	TeamName string
+}
+
+// PRKey namespaces a pull request id by its repository, e.g. "api#42".
+func PRKey(repository, id string) string {
+	if repository == "" || repository == DefaultRepository {
+		return id
+	}
+	return repository + "#" + id
+}
@@ -0,0 +1,97 @@
+package models
+
+import "time"
+
+// WorkSchedule is a user's working hours in their own timezone. Start and End
+// are minutes after local midnight with Start < End; Days is indexed by
+// time.Weekday. A nil schedule means the user is always available.
+type WorkSchedule struct {
+	Location *time.Location
+	Start    int
+	End      int
+	Days     [7]bool
+}
+
+// maxScheduleDays bounds the scan for working time so that a schedule
+// without working days cannot loop forever.
+const maxScheduleDays = 366 * 2
+
+// Contains reports whether t falls within working hours.
+func (s *WorkSchedule) Contains(t time.Time) bool {
+	if s == nil {
+		return true
+	}
+	t = t.In(s.Location)
+	if !s.Days[t.Weekday()] {
+		return false
+	}
+	start, end := s.window(t)
+	return !t.Before(start) && t.Before(end)
+}
+
+// window returns the working interval on the local day of t.
+func (s *WorkSchedule) window(t time.Time) (time.Time, time.Time) {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, s.Start, 0, 0, s.Location), time.Date(y, m, d, 0, s.End, 0, 0, s.Location)
+}
+
+// WorkingTime returns how much of [from, to) falls within working hours.
+func (s *WorkSchedule) WorkingTime(from, to time.Time) time.Duration {
+	if !to.After(from) {
+		return 0
+	}
+	if s == nil {
+		return to.Sub(from)
+	}
+	var total time.Duration
+	day := from.In(s.Location)
+	for i := 0; i < maxScheduleDays && day.Before(to); i++ {
+		if s.Days[day.Weekday()] {
+			start, end := s.window(day)
+			if start.Before(from) {
+				start = from
+			}
+			if end.After(to) {
+				end = to
+			}
+			if end.After(start) {
+				total += end.Sub(start)
+			}
+		}
+		day = nextDay(day)
+	}
+	return total
+}
+
+// AddWorkingTime returns the moment d of working time after from. The second
+// result is false if the schedule has no working hours to reach it.
+func (s *WorkSchedule) AddWorkingTime(from time.Time, d time.Duration) (time.Time, bool) {
+	if s == nil {
+		return from.Add(d), true
+	}
+	day := from.In(s.Location)
+	for i := 0; i < maxScheduleDays; i++ {
+		if s.Days[day.Weekday()] {
+			start, end := s.window(day)
+			if start.Before(from) {
+				start = from
+			}
+			if end.After(start) {
+				avail := end.Sub(start)
+				if d <= avail {
+					return start.Add(d), true
+				}
+				d -= avail
+			}
+		}
+		day = nextDay(day)
+	}
+	return time.Time{}, false
+}
+
+// nextDay returns local midnight of the day after t, correct across DST
+// changes.
+func nextDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d+1, 0, 0, 0, 0, t.Location())
+}
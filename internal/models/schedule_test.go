@@ -0,0 +1,149 @@
+package models
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/clock"
+)
+
+func mustLoad(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("load %s: %v", name, err)
+	}
+	return loc
+}
+
+// weekdays returns a Monday to Friday schedule from start to end o'clock.
+func weekdays(loc *time.Location, start, end int) *WorkSchedule {
+	s := &WorkSchedule{Location: loc, Start: start * 60, End: end * 60}
+	for d := time.Monday; d <= time.Friday; d++ {
+		s.Days[d] = true
+	}
+	return s
+}
+
+func TestContains(t *testing.T) {
+	moscow := mustLoad(t, "Europe/Moscow")
+	novosibirsk := mustLoad(t, "Asia/Novosibirsk")
+	msk := weekdays(moscow, 9, 18)
+	nsk := weekdays(novosibirsk, 9, 18)
+
+	// 7 March 2025 is a Friday.
+	at := func(loc *time.Location, day, hour, min, sec int) time.Time {
+		return time.Date(2025, 3, day, hour, min, sec, 0, loc)
+	}
+	tests := []struct {
+		name  string
+		sched *WorkSchedule
+		t     time.Time
+		want  bool
+	}{
+		{"start of day is inclusive", msk, at(moscow, 7, 9, 0, 0), true},
+		{"before start", msk, at(moscow, 7, 8, 59, 59), false},
+		{"last second of the day", msk, at(moscow, 7, 17, 59, 59), true},
+		{"end of day is exclusive", msk, at(moscow, 7, 18, 0, 0), false},
+		{"saturday", msk, at(moscow, 8, 12, 0, 0), false},
+		{"sunday", msk, at(moscow, 9, 12, 0, 0), false},
+		{"utc instant in moscow hours", msk, at(time.UTC, 7, 14, 30, 0), true},
+		{"same instant after novosibirsk hours", nsk, at(time.UTC, 7, 14, 30, 0), false},
+		{"utc morning in novosibirsk hours", nsk, at(time.UTC, 7, 2, 0, 0), true},
+		{"same instant before moscow hours", msk, at(time.UTC, 7, 2, 0, 0), false},
+		{"utc friday evening is saturday in novosibirsk", nsk, at(time.UTC, 7, 23, 0, 0), false},
+		{"utc sunday evening is monday in novosibirsk", nsk, at(time.UTC, 9, 23, 0, 0), false},
+		{"utc sunday night is monday morning in novosibirsk", nsk, at(time.UTC, 10, 2, 30, 0), true},
+		{"nil schedule", nil, at(moscow, 8, 3, 0, 0), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.sched.Contains(tt.t); got != tt.want {
+				t.Errorf("Contains(%s) = %v, want %v", tt.t, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestContainsOverAWeek(t *testing.T) {
+	moscow := mustLoad(t, "Europe/Moscow")
+	sched := weekdays(moscow, 9, 18)
+
+	// Step a fake clock through a whole week starting Monday midnight: five
+	// days of nine hours are working time.
+	c := clock.NewFake(time.Date(2025, 3, 3, 0, 0, 0, 0, moscow))
+	end := c.Now().AddDate(0, 0, 7)
+	working := 0
+	for ; c.Now().Before(end); c.Advance(15 * time.Minute) {
+		if sched.Contains(c.Now()) {
+			working++
+		}
+	}
+	if want := 5 * 9 * 4; working != want {
+		t.Errorf("working quarters = %d, want %d", working, want)
+	}
+}
+
+func TestAddWorkingTime(t *testing.T) {
+	moscow := mustLoad(t, "Europe/Moscow")
+	novosibirsk := mustLoad(t, "Asia/Novosibirsk")
+	msk := weekdays(moscow, 9, 18)
+	nsk := weekdays(novosibirsk, 10, 19)
+
+	at := func(loc *time.Location, day, hour, min int) time.Time {
+		return time.Date(2025, 3, day, hour, min, 0, 0, loc)
+	}
+	tests := []struct {
+		name  string
+		sched *WorkSchedule
+		from  time.Time
+		d     time.Duration
+		want  time.Time
+	}{
+		{"within a day", msk, at(moscow, 7, 10, 0), 2 * time.Hour, at(moscow, 7, 12, 0)},
+		{"ends exactly at end of day", msk, at(moscow, 7, 16, 0), 2 * time.Hour, at(moscow, 7, 18, 0)},
+		{"rolls over the weekend", msk, at(moscow, 7, 17, 0), 2 * time.Hour, at(moscow, 10, 10, 0)},
+		{"starts on saturday", msk, at(moscow, 8, 12, 0), time.Hour, at(moscow, 10, 10, 0)},
+		{"starts after hours", msk, at(moscow, 7, 19, 0), 30 * time.Minute, at(moscow, 10, 9, 30)},
+		{"starts at end of day", msk, at(moscow, 6, 18, 0), time.Hour, at(moscow, 7, 10, 0)},
+		{"zero before hours waits for start", msk, at(moscow, 10, 7, 0), 0, at(moscow, 10, 9, 0)},
+		{"five working days", msk, at(moscow, 7, 9, 0), 45 * time.Hour, at(moscow, 13, 18, 0)},
+		// 17:00 in Moscow is 21:00 in Novosibirsk, already past its hours.
+		{"novosibirsk reviewer", nsk, at(moscow, 7, 17, 0), time.Hour, at(novosibirsk, 10, 11, 0)},
+		{"novosibirsk until end of day", nsk, at(time.UTC, 7, 10, 0), 2 * time.Hour, at(novosibirsk, 7, 19, 0)},
+		{"nil schedule", nil, at(moscow, 8, 12, 0), 3 * time.Hour, at(moscow, 8, 15, 0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.sched.AddWorkingTime(tt.from, tt.d)
+			if !ok {
+				t.Fatal("AddWorkingTime reported no working hours")
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("AddWorkingTime(%s, %s) = %s, want %s", tt.from, tt.d, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddWorkingTimeWithoutWorkingDays(t *testing.T) {
+	sched := &WorkSchedule{Location: mustLoad(t, "Europe/Moscow"), Start: 9 * 60, End: 18 * 60}
+	if _, ok := sched.AddWorkingTime(time.Date(2025, 3, 7, 10, 0, 0, 0, time.UTC), time.Hour); ok {
+		t.Error("AddWorkingTime succeeded without working days")
+	}
+}
+
+func TestWorkingTimeOverWeekend(t *testing.T) {
+	moscow := mustLoad(t, "Europe/Moscow")
+	sched := weekdays(moscow, 9, 18)
+	from := time.Date(2025, 3, 7, 17, 0, 0, 0, moscow)
+	to := time.Date(2025, 3, 10, 10, 0, 0, 0, moscow)
+	if got := sched.WorkingTime(from, to); got != 2*time.Hour {
+		t.Errorf("WorkingTime = %s, want 2h", got)
+	}
+	// AddWorkingTime and WorkingTime agree.
+	if due, _ := sched.AddWorkingTime(from, 2*time.Hour); !due.Equal(to) {
+		t.Errorf("AddWorkingTime = %s, want %s", due, to)
+	}
+}
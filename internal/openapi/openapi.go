@@ -0,0 +1,152 @@
+package openapi
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/delivery"
+)
+
+//go:embed openapi.json
+var spec []byte
+
+//go:embed docs.html
+var docsPage []byte
+
+// Spec returns the raw OpenAPI document.
+func Spec() []byte {
+	return spec
+}
+
+// Load parses and validates the embedded document.
+func Load() (*openapi3.T, error) {
+	doc, err := openapi3.NewLoader().LoadFromData(spec)
+	if err != nil {
+		return nil, fmt.Errorf("load openapi spec: %w", err)
+	}
+	if err := doc.Validate(context.Background()); err != nil {
+		return nil, fmt.Errorf("validate openapi spec: %w", err)
+	}
+	return doc, nil
+}
+
+func SpecHandler(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(spec)
+}
+
+// DocsHandler serves a page rendering /openapi.json. Its styles and script are
+// inline, so the docs work offline and the policy forbids any other origin.
+func DocsHandler(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Content-Security-Policy",
+		"default-src 'none'; connect-src 'self'; script-src 'unsafe-inline'; style-src 'unsafe-inline'")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(docsPage)
+}
+
+// Validator checks requests and responses against the document. It is meant
+// for development: invalid requests are rejected with 400, invalid responses
+// are only logged.
+type Validator struct {
+	router routers.Router
+	logger *slog.Logger
+}
+
+func NewValidator(logger *slog.Logger) (*Validator, error) {
+	doc, err := Load()
+	if err != nil {
+		return nil, err
+	}
+	// Match on paths only, whatever host the service is reached through.
+	doc.Servers = nil
+	router, err := gorillamux.NewRouter(doc)
+	if err != nil {
+		return nil, fmt.Errorf("build openapi router: %w", err)
+	}
+	return &Validator{router: router, logger: logger}, nil
+}
+
+func (v *Validator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route, params, err := v.router.FindRoute(r)
+		if err != nil {
+			v.logger.Warn("route missing from openapi spec", "method", r.Method, "path", r.URL.Path)
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		reqInput := &openapi3filter.RequestValidationInput{
+			Request:    r,
+			PathParams: params,
+			Route:      route,
+			Options:    &openapi3filter.Options{MultiError: true},
+		}
+		if err := openapi3filter.ValidateRequest(r.Context(), reqInput); err != nil {
+			var reqErr *openapi3filter.RequestError
+			var multi openapi3.MultiError
+			if errors.As(err, &reqErr) || errors.As(err, &multi) {
+				delivery.WriteError(w, http.StatusBadRequest, "INVALID_REQUEST", "request does not match spec: "+err.Error())
+				return
+			}
+			v.logger.Warn("openapi request validation skipped", "err", err)
+		}
+
+		rec := &recorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+
+		respInput := &openapi3filter.ResponseValidationInput{
+			RequestValidationInput: reqInput,
+			Status:                 rec.status,
+			Header:                 rec.Header(),
+			Body:                   io.NopCloser(bytes.NewReader(rec.body.Bytes())),
+			Options:                &openapi3filter.Options{IncludeResponseStatus: true, MultiError: true},
+		}
+		if rec.streaming {
+			return
+		}
+		if err := openapi3filter.ValidateResponse(r.Context(), respInput); err != nil {
+			v.logger.Error("response does not match openapi spec",
+				"method", r.Method, "path", r.URL.Path, "status", rec.status, "err", err)
+		}
+	})
+}
+
+type recorder struct {
+	http.ResponseWriter
+	status    int
+	body      bytes.Buffer
+	streaming bool
+}
+
+func (rw *recorder) WriteHeader(status int) {
+	rw.status = status
+	// Event streams never end, so they are passed through without a copy.
+	rw.streaming = strings.HasPrefix(rw.Header().Get("Content-Type"), "text/event-stream")
+	rw.ResponseWriter.WriteHeader(status)
+}
+
+func (rw *recorder) Write(b []byte) (int, error) {
+	if !rw.streaming {
+		rw.body.Write(b)
+	}
+	return rw.ResponseWriter.Write(b)
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer.
+func (rw *recorder) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
@@ -0,0 +1,3884 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v5.28.3
+// source: prservice/v1/prservice.proto
+
+package prservicev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PullRequestStatus int32
+
+const (
+	PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED PullRequestStatus = 0
+	PullRequestStatus_PULL_REQUEST_STATUS_OPEN        PullRequestStatus = 1
+	PullRequestStatus_PULL_REQUEST_STATUS_MERGED      PullRequestStatus = 2
+)
+
+// Enum value maps for PullRequestStatus.
+var (
+	PullRequestStatus_name = map[int32]string{
+		0: "PULL_REQUEST_STATUS_UNSPECIFIED",
+		1: "PULL_REQUEST_STATUS_OPEN",
+		2: "PULL_REQUEST_STATUS_MERGED",
+	}
+	PullRequestStatus_value = map[string]int32{
+		"PULL_REQUEST_STATUS_UNSPECIFIED": 0,
+		"PULL_REQUEST_STATUS_OPEN":        1,
+		"PULL_REQUEST_STATUS_MERGED":      2,
+	}
+)
+
+func (x PullRequestStatus) Enum() *PullRequestStatus {
+	p := new(PullRequestStatus)
+	*p = x
+	return p
+}
+
+func (x PullRequestStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PullRequestStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_prservice_v1_prservice_proto_enumTypes[0].Descriptor()
+}
+
+func (PullRequestStatus) Type() protoreflect.EnumType {
+	return &file_prservice_v1_prservice_proto_enumTypes[0]
+}
+
+func (x PullRequestStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PullRequestStatus.Descriptor instead.
+func (PullRequestStatus) EnumDescriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{0}
+}
+
+type Member struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	IsActive      bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Member) Reset() {
+	*x = Member{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Member) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Member) ProtoMessage() {}
+
+func (x *Member) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Member.ProtoReflect.Descriptor instead.
+func (*Member) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Member) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Member) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Member) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type Team struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Members       []*Member              `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Team) Reset() {
+	*x = Team{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Team) ProtoMessage() {}
+
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Team) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *Team) GetMembers() []*Member {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	TeamName      string                 `protobuf:"bytes,3,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	IsActive      bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *User) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *User) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *User) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type StringList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []string               `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StringList) Reset() {
+	*x = StringList{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StringList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringList) ProtoMessage() {}
+
+func (x *StringList) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringList.ProtoReflect.Descriptor instead.
+func (*StringList) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StringList) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type PullRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId     string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName   string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId          string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Status            PullRequestStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=prservice.v1.PullRequestStatus" json:"status,omitempty"`
+	AssignedReviewers []string               `protobuf:"bytes,5,rep,name=assigned_reviewers,json=assignedReviewers,proto3" json:"assigned_reviewers,omitempty"`
+	ReviewerTags      map[string]*StringList `protobuf:"bytes,6,rep,name=reviewer_tags,json=reviewerTags,proto3" json:"reviewer_tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	UncoveredTags     []string               `protobuf:"bytes,7,rep,name=uncovered_tags,json=uncoveredTags,proto3" json:"uncovered_tags,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	MergedAt          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=merged_at,json=mergedAt,proto3" json:"merged_at,omitempty"`
+	Explanations      []*ReviewerExplanation `protobuf:"bytes,10,rep,name=explanations,proto3" json:"explanations,omitempty"`
+	PolicyViolations  []string               `protobuf:"bytes,11,rep,name=policy_violations,json=policyViolations,proto3" json:"policy_violations,omitempty"`
+	IsDraft           bool                   `protobuf:"varint,12,opt,name=is_draft,json=isDraft,proto3" json:"is_draft,omitempty"`
+	Repository        string                 `protobuf:"bytes,13,opt,name=repository,proto3" json:"repository,omitempty"`
+	Url               string                 `protobuf:"bytes,14,opt,name=url,proto3" json:"url,omitempty"`
+	Labels            []string               `protobuf:"bytes,15,rep,name=labels,proto3" json:"labels,omitempty"`
+	LinesAdded        int32                  `protobuf:"varint,16,opt,name=lines_added,json=linesAdded,proto3" json:"lines_added,omitempty"`
+	LinesRemoved      int32                  `protobuf:"varint,17,opt,name=lines_removed,json=linesRemoved,proto3" json:"lines_removed,omitempty"`
+	Description       string                 `protobuf:"bytes,18,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PullRequest) Reset() {
+	*x = PullRequest{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequest) ProtoMessage() {}
+
+func (x *PullRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequest.ProtoReflect.Descriptor instead.
+func (*PullRequest) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PullRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PullRequest) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *PullRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *PullRequest) GetStatus() PullRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *PullRequest) GetAssignedReviewers() []string {
+	if x != nil {
+		return x.AssignedReviewers
+	}
+	return nil
+}
+
+func (x *PullRequest) GetReviewerTags() map[string]*StringList {
+	if x != nil {
+		return x.ReviewerTags
+	}
+	return nil
+}
+
+func (x *PullRequest) GetUncoveredTags() []string {
+	if x != nil {
+		return x.UncoveredTags
+	}
+	return nil
+}
+
+func (x *PullRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PullRequest) GetMergedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MergedAt
+	}
+	return nil
+}
+
+func (x *PullRequest) GetExplanations() []*ReviewerExplanation {
+	if x != nil {
+		return x.Explanations
+	}
+	return nil
+}
+
+func (x *PullRequest) GetPolicyViolations() []string {
+	if x != nil {
+		return x.PolicyViolations
+	}
+	return nil
+}
+
+func (x *PullRequest) GetIsDraft() bool {
+	if x != nil {
+		return x.IsDraft
+	}
+	return false
+}
+
+func (x *PullRequest) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *PullRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *PullRequest) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *PullRequest) GetLinesAdded() int32 {
+	if x != nil {
+		return x.LinesAdded
+	}
+	return 0
+}
+
+func (x *PullRequest) GetLinesRemoved() int32 {
+	if x != nil {
+		return x.LinesRemoved
+	}
+	return 0
+}
+
+func (x *PullRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type Exclusion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Exclusion) Reset() {
+	*x = Exclusion{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Exclusion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Exclusion) ProtoMessage() {}
+
+func (x *Exclusion) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Exclusion.ProtoReflect.Descriptor instead.
+func (*Exclusion) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Exclusion) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Exclusion) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// ReviewerExplanation records why a reviewer was picked.
+type ReviewerExplanation struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ReviewerId     string                 `protobuf:"bytes,1,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	Strategy       string                 `protobuf:"bytes,2,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	PoolSize       int32                  `protobuf:"varint,3,opt,name=pool_size,json=poolSize,proto3" json:"pool_size,omitempty"`
+	Excluded       []*Exclusion           `protobuf:"bytes,4,rep,name=excluded,proto3" json:"excluded,omitempty"`
+	MatchedTags    []string               `protobuf:"bytes,5,rep,name=matched_tags,json=matchedTags,proto3" json:"matched_tags,omitempty"`
+	InWorkingHours bool                   `protobuf:"varint,6,opt,name=in_working_hours,json=inWorkingHours,proto3" json:"in_working_hours,omitempty"`
+	Score          int32                  `protobuf:"varint,7,opt,name=score,proto3" json:"score,omitempty"`
+	Seed           int64                  `protobuf:"varint,8,opt,name=seed,proto3" json:"seed,omitempty"`
+	ChosenAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=chosen_at,json=chosenAt,proto3" json:"chosen_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReviewerExplanation) Reset() {
+	*x = ReviewerExplanation{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewerExplanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewerExplanation) ProtoMessage() {}
+
+func (x *ReviewerExplanation) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewerExplanation.ProtoReflect.Descriptor instead.
+func (*ReviewerExplanation) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReviewerExplanation) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *ReviewerExplanation) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *ReviewerExplanation) GetPoolSize() int32 {
+	if x != nil {
+		return x.PoolSize
+	}
+	return 0
+}
+
+func (x *ReviewerExplanation) GetExcluded() []*Exclusion {
+	if x != nil {
+		return x.Excluded
+	}
+	return nil
+}
+
+func (x *ReviewerExplanation) GetMatchedTags() []string {
+	if x != nil {
+		return x.MatchedTags
+	}
+	return nil
+}
+
+func (x *ReviewerExplanation) GetInWorkingHours() bool {
+	if x != nil {
+		return x.InWorkingHours
+	}
+	return false
+}
+
+func (x *ReviewerExplanation) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ReviewerExplanation) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *ReviewerExplanation) GetChosenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChosenAt
+	}
+	return nil
+}
+
+type PullRequestShort struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Status          PullRequestStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=prservice.v1.PullRequestStatus" json:"status,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Repository      string                 `protobuf:"bytes,6,opt,name=repository,proto3" json:"repository,omitempty"`
+	Url             string                 `protobuf:"bytes,7,opt,name=url,proto3" json:"url,omitempty"`
+	Labels          []string               `protobuf:"bytes,8,rep,name=labels,proto3" json:"labels,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PullRequestShort) Reset() {
+	*x = PullRequestShort{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequestShort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequestShort) ProtoMessage() {}
+
+func (x *PullRequestShort) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequestShort.ProtoReflect.Descriptor instead.
+func (*PullRequestShort) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PullRequestShort) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetStatus() PullRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *PullRequestShort) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PullRequestShort) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type TimeRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CreatedAfter  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeRange) Reset() {
+	*x = TimeRange{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeRange) ProtoMessage() {}
+
+func (x *TimeRange) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeRange.ProtoReflect.Descriptor instead.
+func (*TimeRange) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TimeRange) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *TimeRange) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+type Page struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Ascending     bool                   `protobuf:"varint,3,opt,name=ascending,proto3" json:"ascending,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Page) Reset() {
+	*x = Page{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Page) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Page) ProtoMessage() {}
+
+func (x *Page) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Page.ProtoReflect.Descriptor instead.
+func (*Page) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Page) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *Page) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *Page) GetAscending() bool {
+	if x != nil {
+		return x.Ascending
+	}
+	return false
+}
+
+type CreateTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTeamRequest) Reset() {
+	*x = CreateTeamRequest{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTeamRequest) ProtoMessage() {}
+
+func (x *CreateTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTeamRequest.ProtoReflect.Descriptor instead.
+func (*CreateTeamRequest) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateTeamRequest) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type GetTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamRequest) Reset() {
+	*x = GetTeamRequest{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamRequest) ProtoMessage() {}
+
+func (x *GetTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamRequest) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetTeamRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type UpdateTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	OpenPrsPolicy string                 `protobuf:"bytes,2,opt,name=open_prs_policy,json=openPrsPolicy,proto3" json:"open_prs_policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTeamRequest) Reset() {
+	*x = UpdateTeamRequest{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTeamRequest) ProtoMessage() {}
+
+func (x *UpdateTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTeamRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTeamRequest) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateTeamRequest) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+func (x *UpdateTeamRequest) GetOpenPrsPolicy() string {
+	if x != nil {
+		return x.OpenPrsPolicy
+	}
+	return ""
+}
+
+type RemoveTeamMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OpenPrsPolicy string                 `protobuf:"bytes,3,opt,name=open_prs_policy,json=openPrsPolicy,proto3" json:"open_prs_policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTeamMemberRequest) Reset() {
+	*x = RemoveTeamMemberRequest{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTeamMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTeamMemberRequest) ProtoMessage() {}
+
+func (x *RemoveTeamMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTeamMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTeamMemberRequest) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RemoveTeamMemberRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *RemoveTeamMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RemoveTeamMemberRequest) GetOpenPrsPolicy() string {
+	if x != nil {
+		return x.OpenPrsPolicy
+	}
+	return ""
+}
+
+type TeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamResponse) Reset() {
+	*x = TeamResponse{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamResponse) ProtoMessage() {}
+
+func (x *TeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamResponse.ProtoReflect.Descriptor instead.
+func (*TeamResponse) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TeamResponse) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type DeleteTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	OpenPrsPolicy string                 `protobuf:"bytes,2,opt,name=open_prs_policy,json=openPrsPolicy,proto3" json:"open_prs_policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTeamRequest) Reset() {
+	*x = DeleteTeamRequest{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTeamRequest) ProtoMessage() {}
+
+func (x *DeleteTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTeamRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTeamRequest) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteTeamRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *DeleteTeamRequest) GetOpenPrsPolicy() string {
+	if x != nil {
+		return x.OpenPrsPolicy
+	}
+	return ""
+}
+
+type DeleteTeamResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TeamName       string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	RemovedMembers []string               `protobuf:"bytes,2,rep,name=removed_members,json=removedMembers,proto3" json:"removed_members,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteTeamResponse) Reset() {
+	*x = DeleteTeamResponse{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTeamResponse) ProtoMessage() {}
+
+func (x *DeleteTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTeamResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTeamResponse) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeleteTeamResponse) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *DeleteTeamResponse) GetRemovedMembers() []string {
+	if x != nil {
+		return x.RemovedMembers
+	}
+	return nil
+}
+
+type SetTeamCodeownersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTeamCodeownersRequest) Reset() {
+	*x = SetTeamCodeownersRequest{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTeamCodeownersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTeamCodeownersRequest) ProtoMessage() {}
+
+func (x *SetTeamCodeownersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTeamCodeownersRequest.ProtoReflect.Descriptor instead.
+func (*SetTeamCodeownersRequest) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SetTeamCodeownersRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *SetTeamCodeownersRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type CodeownersRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Pattern       string                 `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Owners        []string               `protobuf:"bytes,3,rep,name=owners,proto3" json:"owners,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CodeownersRule) Reset() {
+	*x = CodeownersRule{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CodeownersRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodeownersRule) ProtoMessage() {}
+
+func (x *CodeownersRule) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodeownersRule.ProtoReflect.Descriptor instead.
+func (*CodeownersRule) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CodeownersRule) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *CodeownersRule) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *CodeownersRule) GetOwners() []string {
+	if x != nil {
+		return x.Owners
+	}
+	return nil
+}
+
+type SetTeamCodeownersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Rules         []*CodeownersRule      `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTeamCodeownersResponse) Reset() {
+	*x = SetTeamCodeownersResponse{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTeamCodeownersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTeamCodeownersResponse) ProtoMessage() {}
+
+func (x *SetTeamCodeownersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTeamCodeownersResponse.ProtoReflect.Descriptor instead.
+func (*SetTeamCodeownersResponse) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SetTeamCodeownersResponse) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *SetTeamCodeownersResponse) GetRules() []*CodeownersRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type SetTeamMentorshipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Enabled       bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTeamMentorshipRequest) Reset() {
+	*x = SetTeamMentorshipRequest{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTeamMentorshipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTeamMentorshipRequest) ProtoMessage() {}
+
+func (x *SetTeamMentorshipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTeamMentorshipRequest.ProtoReflect.Descriptor instead.
+func (*SetTeamMentorshipRequest) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SetTeamMentorshipRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *SetTeamMentorshipRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type SetTeamMentorshipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Enabled       bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTeamMentorshipResponse) Reset() {
+	*x = SetTeamMentorshipResponse{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTeamMentorshipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTeamMentorshipResponse) ProtoMessage() {}
+
+func (x *SetTeamMentorshipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTeamMentorshipResponse.ProtoReflect.Descriptor instead.
+func (*SetTeamMentorshipResponse) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SetTeamMentorshipResponse) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *SetTeamMentorshipResponse) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// SetTeamLargePRsRequest sets the changed-line count from which pull
+// requests get an extra reviewer; zero turns this off.
+type SetTeamLargePRsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	MinLines      int32                  `protobuf:"varint,2,opt,name=min_lines,json=minLines,proto3" json:"min_lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTeamLargePRsRequest) Reset() {
+	*x = SetTeamLargePRsRequest{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTeamLargePRsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTeamLargePRsRequest) ProtoMessage() {}
+
+func (x *SetTeamLargePRsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTeamLargePRsRequest.ProtoReflect.Descriptor instead.
+func (*SetTeamLargePRsRequest) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SetTeamLargePRsRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *SetTeamLargePRsRequest) GetMinLines() int32 {
+	if x != nil {
+		return x.MinLines
+	}
+	return 0
+}
+
+type SetTeamLargePRsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	MinLines      int32                  `protobuf:"varint,2,opt,name=min_lines,json=minLines,proto3" json:"min_lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTeamLargePRsResponse) Reset() {
+	*x = SetTeamLargePRsResponse{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTeamLargePRsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTeamLargePRsResponse) ProtoMessage() {}
+
+func (x *SetTeamLargePRsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTeamLargePRsResponse.ProtoReflect.Descriptor instead.
+func (*SetTeamLargePRsResponse) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetTeamLargePRsResponse) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *SetTeamLargePRsResponse) GetMinLines() int32 {
+	if x != nil {
+		return x.MinLines
+	}
+	return 0
+}
+
+// PairRule pins or bans a reviewer for pull requests by an author: never
+// keeps reviewer_id off them, always assigns reviewer_id to every one.
+type PairRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	ReviewerId    string                 `protobuf:"bytes,2,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	AuthorId      string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PairRule) Reset() {
+	*x = PairRule{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PairRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairRule) ProtoMessage() {}
+
+func (x *PairRule) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairRule.ProtoReflect.Descriptor instead.
+func (*PairRule) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PairRule) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PairRule) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *PairRule) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+// SetTeamRulesRequest replaces all pair rules of a team; an empty list
+// removes them.
+type SetTeamRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Rules         []*PairRule            `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTeamRulesRequest) Reset() {
+	*x = SetTeamRulesRequest{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTeamRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTeamRulesRequest) ProtoMessage() {}
+
+func (x *SetTeamRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTeamRulesRequest.ProtoReflect.Descriptor instead.
+func (*SetTeamRulesRequest) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SetTeamRulesRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *SetTeamRulesRequest) GetRules() []*PairRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type GetTeamRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamRulesRequest) Reset() {
+	*x = GetTeamRulesRequest{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamRulesRequest) ProtoMessage() {}
+
+func (x *GetTeamRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamRulesRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamRulesRequest) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetTeamRulesRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type TeamRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Rules         []*PairRule            `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamRulesResponse) Reset() {
+	*x = TeamRulesResponse{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamRulesResponse) ProtoMessage() {}
+
+func (x *TeamRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamRulesResponse.ProtoReflect.Descriptor instead.
+func (*TeamRulesResponse) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *TeamRulesResponse) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *TeamRulesResponse) GetRules() []*PairRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// Repository maps a repository to the team reviewing its pull requests;
+// without a team the author's team reviews them.
+type Repository struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Repository    string                 `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	TeamName      string                 `protobuf:"bytes,2,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Repository) Reset() {
+	*x = Repository{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Repository) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Repository) ProtoMessage() {}
+
+func (x *Repository) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Repository.ProtoReflect.Descriptor instead.
+func (*Repository) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Repository) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *Repository) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type SetRepositoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Repository    *Repository            `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRepositoryRequest) Reset() {
+	*x = SetRepositoryRequest{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRepositoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRepositoryRequest) ProtoMessage() {}
+
+func (x *SetRepositoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRepositoryRequest.ProtoReflect.Descriptor instead.
+func (*SetRepositoryRequest) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SetRepositoryRequest) GetRepository() *Repository {
+	if x != nil {
+		return x.Repository
+	}
+	return nil
+}
+
+type RepositoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Repository    *Repository            `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RepositoryResponse) Reset() {
+	*x = RepositoryResponse{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepositoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepositoryResponse) ProtoMessage() {}
+
+func (x *RepositoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepositoryResponse.ProtoReflect.Descriptor instead.
+func (*RepositoryResponse) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RepositoryResponse) GetRepository() *Repository {
+	if x != nil {
+		return x.Repository
+	}
+	return nil
+}
+
+type ListRepositoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRepositoriesRequest) Reset() {
+	*x = ListRepositoriesRequest{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRepositoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRepositoriesRequest) ProtoMessage() {}
+
+func (x *ListRepositoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRepositoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListRepositoriesRequest) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{31}
+}
+
+type ListRepositoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Repositories  []*Repository          `protobuf:"bytes,1,rep,name=repositories,proto3" json:"repositories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRepositoriesResponse) Reset() {
+	*x = ListRepositoriesResponse{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRepositoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRepositoriesResponse) ProtoMessage() {}
+
+func (x *ListRepositoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRepositoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListRepositoriesResponse) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListRepositoriesResponse) GetRepositories() []*Repository {
+	if x != nil {
+		return x.Repositories
+	}
+	return nil
+}
+
+type GetUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRequest) ProtoMessage() {}
+
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUserResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	User            *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Tags            []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	IsAvailable     bool                   `protobuf:"varint,3,opt,name=is_available,json=isAvailable,proto3" json:"is_available,omitempty"`
+	OpenReviewCount int32                  `protobuf:"varint,4,opt,name=open_review_count,json=openReviewCount,proto3" json:"open_review_count,omitempty"`
+	AuthoredOpenPrs []string               `protobuf:"bytes,5,rep,name=authored_open_prs,json=authoredOpenPrs,proto3" json:"authored_open_prs,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetUserResponse) Reset() {
+	*x = GetUserResponse{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserResponse) ProtoMessage() {}
+
+func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
+func (*GetUserResponse) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *GetUserResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *GetUserResponse) GetIsAvailable() bool {
+	if x != nil {
+		return x.IsAvailable
+	}
+	return false
+}
+
+func (x *GetUserResponse) GetOpenReviewCount() int32 {
+	if x != nil {
+		return x.OpenReviewCount
+	}
+	return 0
+}
+
+func (x *GetUserResponse) GetAuthoredOpenPrs() []string {
+	if x != nil {
+		return x.AuthoredOpenPrs
+	}
+	return nil
+}
+
+type ListUsersRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TeamName       string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	IsActive       *bool                  `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	UsernamePrefix string                 `protobuf:"bytes,3,opt,name=username_prefix,json=usernamePrefix,proto3" json:"username_prefix,omitempty"`
+	Limit          int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor         string                 `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListUsersRequest) Reset() {
+	*x = ListUsersRequest{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersRequest) ProtoMessage() {}
+
+func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListUsersRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+func (x *ListUsersRequest) GetUsernamePrefix() string {
+	if x != nil {
+		return x.UsernamePrefix
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListUsersRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ListUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersResponse) Reset() {
+	*x = ListUsersResponse{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersResponse) ProtoMessage() {}
+
+func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListUsersResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListUsersResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type SetUserActiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IsActive      bool                   `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserActiveRequest) Reset() {
+	*x = SetUserActiveRequest{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserActiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserActiveRequest) ProtoMessage() {}
+
+func (x *SetUserActiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserActiveRequest.ProtoReflect.Descriptor instead.
+func (*SetUserActiveRequest) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SetUserActiveRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetUserActiveRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type UserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserResponse) Reset() {
+	*x = UserResponse{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserResponse) ProtoMessage() {}
+
+func (x *UserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserResponse.ProtoReflect.Descriptor instead.
+func (*UserResponse) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type SetUserTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Tags          []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserTagsRequest) Reset() {
+	*x = SetUserTagsRequest{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserTagsRequest) ProtoMessage() {}
+
+func (x *SetUserTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserTagsRequest.ProtoReflect.Descriptor instead.
+func (*SetUserTagsRequest) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SetUserTagsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetUserTagsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type SetUserTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Tags          []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserTagsResponse) Reset() {
+	*x = SetUserTagsResponse{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserTagsResponse) ProtoMessage() {}
+
+func (x *SetUserTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserTagsResponse.ProtoReflect.Descriptor instead.
+func (*SetUserTagsResponse) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SetUserTagsResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetUserTagsResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type SetUserSeniorityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Seniority     string                 `protobuf:"bytes,2,opt,name=seniority,proto3" json:"seniority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserSeniorityRequest) Reset() {
+	*x = SetUserSeniorityRequest{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserSeniorityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserSeniorityRequest) ProtoMessage() {}
+
+func (x *SetUserSeniorityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserSeniorityRequest.ProtoReflect.Descriptor instead.
+func (*SetUserSeniorityRequest) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SetUserSeniorityRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetUserSeniorityRequest) GetSeniority() string {
+	if x != nil {
+		return x.Seniority
+	}
+	return ""
+}
+
+type SetUserSeniorityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Seniority     string                 `protobuf:"bytes,2,opt,name=seniority,proto3" json:"seniority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserSeniorityResponse) Reset() {
+	*x = SetUserSeniorityResponse{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserSeniorityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserSeniorityResponse) ProtoMessage() {}
+
+func (x *SetUserSeniorityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserSeniorityResponse.ProtoReflect.Descriptor instead.
+func (*SetUserSeniorityResponse) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SetUserSeniorityResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetUserSeniorityResponse) GetSeniority() string {
+	if x != nil {
+		return x.Seniority
+	}
+	return ""
+}
+
+type MoveUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TeamName      string                 `protobuf:"bytes,2,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	ReviewPolicy  string                 `protobuf:"bytes,3,opt,name=review_policy,json=reviewPolicy,proto3" json:"review_policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveUserRequest) Reset() {
+	*x = MoveUserRequest{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveUserRequest) ProtoMessage() {}
+
+func (x *MoveUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveUserRequest.ProtoReflect.Descriptor instead.
+func (*MoveUserRequest) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *MoveUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MoveUserRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *MoveUserRequest) GetReviewPolicy() string {
+	if x != nil {
+		return x.ReviewPolicy
+	}
+	return ""
+}
+
+type MoveUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	ReassignedPrs []string               `protobuf:"bytes,2,rep,name=reassigned_prs,json=reassignedPrs,proto3" json:"reassigned_prs,omitempty"`
+	RemovedPrs    []string               `protobuf:"bytes,3,rep,name=removed_prs,json=removedPrs,proto3" json:"removed_prs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveUserResponse) Reset() {
+	*x = MoveUserResponse{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveUserResponse) ProtoMessage() {}
+
+func (x *MoveUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveUserResponse.ProtoReflect.Descriptor instead.
+func (*MoveUserResponse) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *MoveUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *MoveUserResponse) GetReassignedPrs() []string {
+	if x != nil {
+		return x.ReassignedPrs
+	}
+	return nil
+}
+
+func (x *MoveUserResponse) GetRemovedPrs() []string {
+	if x != nil {
+		return x.RemovedPrs
+	}
+	return nil
+}
+
+type GetUserReviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status        PullRequestStatus      `protobuf:"varint,2,opt,name=status,proto3,enum=prservice.v1.PullRequestStatus" json:"status,omitempty"`
+	AuthorId      string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Created       *TimeRange             `protobuf:"bytes,4,opt,name=created,proto3" json:"created,omitempty"`
+	Page          *Page                  `protobuf:"bytes,5,opt,name=page,proto3" json:"page,omitempty"`
+	Repository    string                 `protobuf:"bytes,6,opt,name=repository,proto3" json:"repository,omitempty"`
+	Label         string                 `protobuf:"bytes,7,opt,name=label,proto3" json:"label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserReviewsRequest) Reset() {
+	*x = GetUserReviewsRequest{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserReviewsRequest) ProtoMessage() {}
+
+func (x *GetUserReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserReviewsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetUserReviewsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetUserReviewsRequest) GetStatus() PullRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *GetUserReviewsRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *GetUserReviewsRequest) GetCreated() *TimeRange {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *GetUserReviewsRequest) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *GetUserReviewsRequest) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *GetUserReviewsRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+type GetUserReviewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PullRequests  []*PullRequestShort    `protobuf:"bytes,2,rep,name=pull_requests,json=pullRequests,proto3" json:"pull_requests,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserReviewsResponse) Reset() {
+	*x = GetUserReviewsResponse{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserReviewsResponse) ProtoMessage() {}
+
+func (x *GetUserReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserReviewsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetUserReviewsResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetUserReviewsResponse) GetPullRequests() []*PullRequestShort {
+	if x != nil {
+		return x.PullRequests
+	}
+	return nil
+}
+
+func (x *GetUserReviewsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type CreatePullRequestRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	ChangedFiles    []string               `protobuf:"bytes,4,rep,name=changed_files,json=changedFiles,proto3" json:"changed_files,omitempty"`
+	RequiredTags    []string               `protobuf:"bytes,5,rep,name=required_tags,json=requiredTags,proto3" json:"required_tags,omitempty"`
+	IsDraft         bool                   `protobuf:"varint,6,opt,name=is_draft,json=isDraft,proto3" json:"is_draft,omitempty"`
+	Repository      string                 `protobuf:"bytes,7,opt,name=repository,proto3" json:"repository,omitempty"`
+	Url             string                 `protobuf:"bytes,8,opt,name=url,proto3" json:"url,omitempty"`
+	Labels          []string               `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty"`
+	LinesAdded      int32                  `protobuf:"varint,10,opt,name=lines_added,json=linesAdded,proto3" json:"lines_added,omitempty"`
+	LinesRemoved    int32                  `protobuf:"varint,11,opt,name=lines_removed,json=linesRemoved,proto3" json:"lines_removed,omitempty"`
+	Description     string                 `protobuf:"bytes,12,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreatePullRequestRequest) Reset() {
+	*x = CreatePullRequestRequest{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePullRequestRequest) ProtoMessage() {}
+
+func (x *CreatePullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePullRequestRequest.ProtoReflect.Descriptor instead.
+func (*CreatePullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CreatePullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetChangedFiles() []string {
+	if x != nil {
+		return x.ChangedFiles
+	}
+	return nil
+}
+
+func (x *CreatePullRequestRequest) GetRequiredTags() []string {
+	if x != nil {
+		return x.RequiredTags
+	}
+	return nil
+}
+
+func (x *CreatePullRequestRequest) GetIsDraft() bool {
+	if x != nil {
+		return x.IsDraft
+	}
+	return false
+}
+
+func (x *CreatePullRequestRequest) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *CreatePullRequestRequest) GetLinesAdded() int32 {
+	if x != nil {
+		return x.LinesAdded
+	}
+	return 0
+}
+
+func (x *CreatePullRequestRequest) GetLinesRemoved() int32 {
+	if x != nil {
+		return x.LinesRemoved
+	}
+	return 0
+}
+
+func (x *CreatePullRequestRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type GetPullRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPullRequestRequest) Reset() {
+	*x = GetPullRequestRequest{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPullRequestRequest) ProtoMessage() {}
+
+func (x *GetPullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPullRequestRequest.ProtoReflect.Descriptor instead.
+func (*GetPullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetPullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+type PullRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pr            *PullRequest           `protobuf:"bytes,1,opt,name=pr,proto3" json:"pr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PullRequestResponse) Reset() {
+	*x = PullRequestResponse{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequestResponse) ProtoMessage() {}
+
+func (x *PullRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequestResponse.ProtoReflect.Descriptor instead.
+func (*PullRequestResponse) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *PullRequestResponse) GetPr() *PullRequest {
+	if x != nil {
+		return x.Pr
+	}
+	return nil
+}
+
+type ListPullRequestsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Pull requests authored or reviewed by the team's members, or in
+	// repositories the team owns.
+	TeamName      string            `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	AuthorId      string            `protobuf:"bytes,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	ReviewerId    string            `protobuf:"bytes,3,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	Status        PullRequestStatus `protobuf:"varint,4,opt,name=status,proto3,enum=prservice.v1.PullRequestStatus" json:"status,omitempty"`
+	Name          string            `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Created       *TimeRange        `protobuf:"bytes,6,opt,name=created,proto3" json:"created,omitempty"`
+	Page          *Page             `protobuf:"bytes,7,opt,name=page,proto3" json:"page,omitempty"`
+	Repository    string            `protobuf:"bytes,8,opt,name=repository,proto3" json:"repository,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPullRequestsRequest) Reset() {
+	*x = ListPullRequestsRequest{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPullRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPullRequestsRequest) ProtoMessage() {}
+
+func (x *ListPullRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPullRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListPullRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListPullRequestsRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *ListPullRequestsRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *ListPullRequestsRequest) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *ListPullRequestsRequest) GetStatus() PullRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *ListPullRequestsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListPullRequestsRequest) GetCreated() *TimeRange {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *ListPullRequestsRequest) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListPullRequestsRequest) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+type ListPullRequestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequests  []*PullRequest         `protobuf:"bytes,1,rep,name=pull_requests,json=pullRequests,proto3" json:"pull_requests,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPullRequestsResponse) Reset() {
+	*x = ListPullRequestsResponse{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPullRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPullRequestsResponse) ProtoMessage() {}
+
+func (x *ListPullRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPullRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListPullRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListPullRequestsResponse) GetPullRequests() []*PullRequest {
+	if x != nil {
+		return x.PullRequests
+	}
+	return nil
+}
+
+func (x *ListPullRequestsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type ReadyPullRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadyPullRequestRequest) Reset() {
+	*x = ReadyPullRequestRequest{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadyPullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadyPullRequestRequest) ProtoMessage() {}
+
+func (x *ReadyPullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadyPullRequestRequest.ProtoReflect.Descriptor instead.
+func (*ReadyPullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ReadyPullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+type MergePullRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergePullRequestRequest) Reset() {
+	*x = MergePullRequestRequest{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergePullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergePullRequestRequest) ProtoMessage() {}
+
+func (x *MergePullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergePullRequestRequest.ProtoReflect.Descriptor instead.
+func (*MergePullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *MergePullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+type ReassignReviewerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	OldUserId     string                 `protobuf:"bytes,2,opt,name=old_user_id,json=oldUserId,proto3" json:"old_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignReviewerRequest) Reset() {
+	*x = ReassignReviewerRequest{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignReviewerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignReviewerRequest) ProtoMessage() {}
+
+func (x *ReassignReviewerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignReviewerRequest.ProtoReflect.Descriptor instead.
+func (*ReassignReviewerRequest) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ReassignReviewerRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *ReassignReviewerRequest) GetOldUserId() string {
+	if x != nil {
+		return x.OldUserId
+	}
+	return ""
+}
+
+type ReassignReviewerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pr            *PullRequest           `protobuf:"bytes,1,opt,name=pr,proto3" json:"pr,omitempty"`
+	ReplacedBy    string                 `protobuf:"bytes,2,opt,name=replaced_by,json=replacedBy,proto3" json:"replaced_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignReviewerResponse) Reset() {
+	*x = ReassignReviewerResponse{}
+	mi := &file_prservice_v1_prservice_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignReviewerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignReviewerResponse) ProtoMessage() {}
+
+func (x *ReassignReviewerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prservice_v1_prservice_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignReviewerResponse.ProtoReflect.Descriptor instead.
+func (*ReassignReviewerResponse) Descriptor() ([]byte, []int) {
+	return file_prservice_v1_prservice_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ReassignReviewerResponse) GetPr() *PullRequest {
+	if x != nil {
+		return x.Pr
+	}
+	return nil
+}
+
+func (x *ReassignReviewerResponse) GetReplacedBy() string {
+	if x != nil {
+		return x.ReplacedBy
+	}
+	return ""
+}
+
+var File_prservice_v1_prservice_proto protoreflect.FileDescriptor
+
+const file_prservice_v1_prservice_proto_rawDesc = "" +
+	"\n" +
+	"\x1cprservice/v1/prservice.proto\x12\fprservice.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"Z\n" +
+	"\x06Member\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tis_active\x18\x03 \x01(\bR\bisActive\"S\n" +
+	"\x04Team\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12.\n" +
+	"\amembers\x18\x02 \x03(\v2\x14.prservice.v1.MemberR\amembers\"u\n" +
+	"\x04User\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tteam_name\x18\x03 \x01(\tR\bteamName\x12\x1b\n" +
+	"\tis_active\x18\x04 \x01(\bR\bisActive\"$\n" +
+	"\n" +
+	"StringList\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\tR\x06values\"\xef\x06\n" +
+	"\vPullRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x127\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1f.prservice.v1.PullRequestStatusR\x06status\x12-\n" +
+	"\x12assigned_reviewers\x18\x05 \x03(\tR\x11assignedReviewers\x12P\n" +
+	"\rreviewer_tags\x18\x06 \x03(\v2+.prservice.v1.PullRequest.ReviewerTagsEntryR\freviewerTags\x12%\n" +
+	"\x0euncovered_tags\x18\a \x03(\tR\runcoveredTags\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
+	"\tmerged_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\bmergedAt\x12E\n" +
+	"\fexplanations\x18\n" +
+	" \x03(\v2!.prservice.v1.ReviewerExplanationR\fexplanations\x12+\n" +
+	"\x11policy_violations\x18\v \x03(\tR\x10policyViolations\x12\x19\n" +
+	"\bis_draft\x18\f \x01(\bR\aisDraft\x12\x1e\n" +
+	"\n" +
+	"repository\x18\r \x01(\tR\n" +
+	"repository\x12\x10\n" +
+	"\x03url\x18\x0e \x01(\tR\x03url\x12\x16\n" +
+	"\x06labels\x18\x0f \x03(\tR\x06labels\x12\x1f\n" +
+	"\vlines_added\x18\x10 \x01(\x05R\n" +
+	"linesAdded\x12#\n" +
+	"\rlines_removed\x18\x11 \x01(\x05R\flinesRemoved\x12 \n" +
+	"\vdescription\x18\x12 \x01(\tR\vdescription\x1aY\n" +
+	"\x11ReviewerTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12.\n" +
+	"\x05value\x18\x02 \x01(\v2\x18.prservice.v1.StringListR\x05value:\x028\x01\"<\n" +
+	"\tExclusion\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\xd4\x02\n" +
+	"\x13ReviewerExplanation\x12\x1f\n" +
+	"\vreviewer_id\x18\x01 \x01(\tR\n" +
+	"reviewerId\x12\x1a\n" +
+	"\bstrategy\x18\x02 \x01(\tR\bstrategy\x12\x1b\n" +
+	"\tpool_size\x18\x03 \x01(\x05R\bpoolSize\x123\n" +
+	"\bexcluded\x18\x04 \x03(\v2\x17.prservice.v1.ExclusionR\bexcluded\x12!\n" +
+	"\fmatched_tags\x18\x05 \x03(\tR\vmatchedTags\x12(\n" +
+	"\x10in_working_hours\x18\x06 \x01(\bR\x0einWorkingHours\x12\x14\n" +
+	"\x05score\x18\a \x01(\x05R\x05score\x12\x12\n" +
+	"\x04seed\x18\b \x01(\x03R\x04seed\x127\n" +
+	"\tchosen_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\bchosenAt\"\xc1\x02\n" +
+	"\x10PullRequestShort\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x127\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1f.prservice.v1.PullRequestStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1e\n" +
+	"\n" +
+	"repository\x18\x06 \x01(\tR\n" +
+	"repository\x12\x10\n" +
+	"\x03url\x18\a \x01(\tR\x03url\x12\x16\n" +
+	"\x06labels\x18\b \x03(\tR\x06labels\"\x8f\x01\n" +
+	"\tTimeRange\x12?\n" +
+	"\rcreated_after\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
+	"\x0ecreated_before\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\"R\n" +
+	"\x04Page\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x1c\n" +
+	"\tascending\x18\x03 \x01(\bR\tascending\";\n" +
+	"\x11CreateTeamRequest\x12&\n" +
+	"\x04team\x18\x01 \x01(\v2\x12.prservice.v1.TeamR\x04team\"-\n" +
+	"\x0eGetTeamRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\"c\n" +
+	"\x11UpdateTeamRequest\x12&\n" +
+	"\x04team\x18\x01 \x01(\v2\x12.prservice.v1.TeamR\x04team\x12&\n" +
+	"\x0fopen_prs_policy\x18\x02 \x01(\tR\ropenPrsPolicy\"w\n" +
+	"\x17RemoveTeamMemberRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12&\n" +
+	"\x0fopen_prs_policy\x18\x03 \x01(\tR\ropenPrsPolicy\"6\n" +
+	"\fTeamResponse\x12&\n" +
+	"\x04team\x18\x01 \x01(\v2\x12.prservice.v1.TeamR\x04team\"X\n" +
+	"\x11DeleteTeamRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12&\n" +
+	"\x0fopen_prs_policy\x18\x02 \x01(\tR\ropenPrsPolicy\"Z\n" +
+	"\x12DeleteTeamResponse\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12'\n" +
+	"\x0fremoved_members\x18\x02 \x03(\tR\x0eremovedMembers\"Q\n" +
+	"\x18SetTeamCodeownersRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"V\n" +
+	"\x0eCodeownersRule\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x18\n" +
+	"\apattern\x18\x02 \x01(\tR\apattern\x12\x16\n" +
+	"\x06owners\x18\x03 \x03(\tR\x06owners\"l\n" +
+	"\x19SetTeamCodeownersResponse\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x122\n" +
+	"\x05rules\x18\x02 \x03(\v2\x1c.prservice.v1.CodeownersRuleR\x05rules\"Q\n" +
+	"\x18SetTeamMentorshipRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\"R\n" +
+	"\x19SetTeamMentorshipResponse\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\"R\n" +
+	"\x16SetTeamLargePRsRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12\x1b\n" +
+	"\tmin_lines\x18\x02 \x01(\x05R\bminLines\"S\n" +
+	"\x17SetTeamLargePRsResponse\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12\x1b\n" +
+	"\tmin_lines\x18\x02 \x01(\x05R\bminLines\"\\\n" +
+	"\bPairRule\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x1f\n" +
+	"\vreviewer_id\x18\x02 \x01(\tR\n" +
+	"reviewerId\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\"`\n" +
+	"\x13SetTeamRulesRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12,\n" +
+	"\x05rules\x18\x02 \x03(\v2\x16.prservice.v1.PairRuleR\x05rules\"2\n" +
+	"\x13GetTeamRulesRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\"^\n" +
+	"\x11TeamRulesResponse\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12,\n" +
+	"\x05rules\x18\x02 \x03(\v2\x16.prservice.v1.PairRuleR\x05rules\"I\n" +
+	"\n" +
+	"Repository\x12\x1e\n" +
+	"\n" +
+	"repository\x18\x01 \x01(\tR\n" +
+	"repository\x12\x1b\n" +
+	"\tteam_name\x18\x02 \x01(\tR\bteamName\"P\n" +
+	"\x14SetRepositoryRequest\x128\n" +
+	"\n" +
+	"repository\x18\x01 \x01(\v2\x18.prservice.v1.RepositoryR\n" +
+	"repository\"N\n" +
+	"\x12RepositoryResponse\x128\n" +
+	"\n" +
+	"repository\x18\x01 \x01(\v2\x18.prservice.v1.RepositoryR\n" +
+	"repository\"\x19\n" +
+	"\x17ListRepositoriesRequest\"X\n" +
+	"\x18ListRepositoriesResponse\x12<\n" +
+	"\frepositories\x18\x01 \x03(\v2\x18.prservice.v1.RepositoryR\frepositories\")\n" +
+	"\x0eGetUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xc8\x01\n" +
+	"\x0fGetUserResponse\x12&\n" +
+	"\x04user\x18\x01 \x01(\v2\x12.prservice.v1.UserR\x04user\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\x12!\n" +
+	"\fis_available\x18\x03 \x01(\bR\visAvailable\x12*\n" +
+	"\x11open_review_count\x18\x04 \x01(\x05R\x0fopenReviewCount\x12*\n" +
+	"\x11authored_open_prs\x18\x05 \x03(\tR\x0fauthoredOpenPrs\"\xb6\x01\n" +
+	"\x10ListUsersRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12 \n" +
+	"\tis_active\x18\x02 \x01(\bH\x00R\bisActive\x88\x01\x01\x12'\n" +
+	"\x0fusername_prefix\x18\x03 \x01(\tR\x0eusernamePrefix\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\x05 \x01(\tR\x06cursorB\f\n" +
+	"\n" +
+	"_is_active\"^\n" +
+	"\x11ListUsersResponse\x12(\n" +
+	"\x05users\x18\x01 \x03(\v2\x12.prservice.v1.UserR\x05users\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"L\n" +
+	"\x14SetUserActiveRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tis_active\x18\x02 \x01(\bR\bisActive\"6\n" +
+	"\fUserResponse\x12&\n" +
+	"\x04user\x18\x01 \x01(\v2\x12.prservice.v1.UserR\x04user\"A\n" +
+	"\x12SetUserTagsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\"B\n" +
+	"\x13SetUserTagsResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\"P\n" +
+	"\x17SetUserSeniorityRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1c\n" +
+	"\tseniority\x18\x02 \x01(\tR\tseniority\"Q\n" +
+	"\x18SetUserSeniorityResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1c\n" +
+	"\tseniority\x18\x02 \x01(\tR\tseniority\"l\n" +
+	"\x0fMoveUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tteam_name\x18\x02 \x01(\tR\bteamName\x12#\n" +
+	"\rreview_policy\x18\x03 \x01(\tR\freviewPolicy\"\x82\x01\n" +
+	"\x10MoveUserResponse\x12&\n" +
+	"\x04user\x18\x01 \x01(\v2\x12.prservice.v1.UserR\x04user\x12%\n" +
+	"\x0ereassigned_prs\x18\x02 \x03(\tR\rreassignedPrs\x12\x1f\n" +
+	"\vremoved_prs\x18\x03 \x03(\tR\n" +
+	"removedPrs\"\x97\x02\n" +
+	"\x15GetUserReviewsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x127\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1f.prservice.v1.PullRequestStatusR\x06status\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x121\n" +
+	"\acreated\x18\x04 \x01(\v2\x17.prservice.v1.TimeRangeR\acreated\x12&\n" +
+	"\x04page\x18\x05 \x01(\v2\x12.prservice.v1.PageR\x04page\x12\x1e\n" +
+	"\n" +
+	"repository\x18\x06 \x01(\tR\n" +
+	"repository\x12\x14\n" +
+	"\x05label\x18\a \x01(\tR\x05label\"\x97\x01\n" +
+	"\x16GetUserReviewsResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12C\n" +
+	"\rpull_requests\x18\x02 \x03(\v2\x1e.prservice.v1.PullRequestShortR\fpullRequests\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\"\xa2\x03\n" +
+	"\x18CreatePullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12#\n" +
+	"\rchanged_files\x18\x04 \x03(\tR\fchangedFiles\x12#\n" +
+	"\rrequired_tags\x18\x05 \x03(\tR\frequiredTags\x12\x19\n" +
+	"\bis_draft\x18\x06 \x01(\bR\aisDraft\x12\x1e\n" +
+	"\n" +
+	"repository\x18\a \x01(\tR\n" +
+	"repository\x12\x10\n" +
+	"\x03url\x18\b \x01(\tR\x03url\x12\x16\n" +
+	"\x06labels\x18\t \x03(\tR\x06labels\x12\x1f\n" +
+	"\vlines_added\x18\n" +
+	" \x01(\x05R\n" +
+	"linesAdded\x12#\n" +
+	"\rlines_removed\x18\v \x01(\x05R\flinesRemoved\x12 \n" +
+	"\vdescription\x18\f \x01(\tR\vdescription\"?\n" +
+	"\x15GetPullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\"@\n" +
+	"\x13PullRequestResponse\x12)\n" +
+	"\x02pr\x18\x01 \x01(\v2\x19.prservice.v1.PullRequestR\x02pr\"\xbc\x02\n" +
+	"\x17ListPullRequestsRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12\x1b\n" +
+	"\tauthor_id\x18\x02 \x01(\tR\bauthorId\x12\x1f\n" +
+	"\vreviewer_id\x18\x03 \x01(\tR\n" +
+	"reviewerId\x127\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1f.prservice.v1.PullRequestStatusR\x06status\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x121\n" +
+	"\acreated\x18\x06 \x01(\v2\x17.prservice.v1.TimeRangeR\acreated\x12&\n" +
+	"\x04page\x18\a \x01(\v2\x12.prservice.v1.PageR\x04page\x12\x1e\n" +
+	"\n" +
+	"repository\x18\b \x01(\tR\n" +
+	"repository\"{\n" +
+	"\x18ListPullRequestsResponse\x12>\n" +
+	"\rpull_requests\x18\x01 \x03(\v2\x19.prservice.v1.PullRequestR\fpullRequests\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"A\n" +
+	"\x17ReadyPullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\"A\n" +
+	"\x17MergePullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\"a\n" +
+	"\x17ReassignReviewerRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12\x1e\n" +
+	"\vold_user_id\x18\x02 \x01(\tR\toldUserId\"f\n" +
+	"\x18ReassignReviewerResponse\x12)\n" +
+	"\x02pr\x18\x01 \x01(\v2\x19.prservice.v1.PullRequestR\x02pr\x12\x1f\n" +
+	"\vreplaced_by\x18\x02 \x01(\tR\n" +
+	"replacedBy*v\n" +
+	"\x11PullRequestStatus\x12#\n" +
+	"\x1fPULL_REQUEST_STATUS_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18PULL_REQUEST_STATUS_OPEN\x10\x01\x12\x1e\n" +
+	"\x1aPULL_REQUEST_STATUS_MERGED\x10\x022\x9e\x11\n" +
+	"\tPRService\x12I\n" +
+	"\n" +
+	"CreateTeam\x12\x1f.prservice.v1.CreateTeamRequest\x1a\x1a.prservice.v1.TeamResponse\x12C\n" +
+	"\aGetTeam\x12\x1c.prservice.v1.GetTeamRequest\x1a\x1a.prservice.v1.TeamResponse\x12I\n" +
+	"\n" +
+	"UpdateTeam\x12\x1f.prservice.v1.UpdateTeamRequest\x1a\x1a.prservice.v1.TeamResponse\x12U\n" +
+	"\x10RemoveTeamMember\x12%.prservice.v1.RemoveTeamMemberRequest\x1a\x1a.prservice.v1.TeamResponse\x12O\n" +
+	"\n" +
+	"DeleteTeam\x12\x1f.prservice.v1.DeleteTeamRequest\x1a .prservice.v1.DeleteTeamResponse\x12d\n" +
+	"\x11SetTeamCodeowners\x12&.prservice.v1.SetTeamCodeownersRequest\x1a'.prservice.v1.SetTeamCodeownersResponse\x12d\n" +
+	"\x11SetTeamMentorship\x12&.prservice.v1.SetTeamMentorshipRequest\x1a'.prservice.v1.SetTeamMentorshipResponse\x12^\n" +
+	"\x0fSetTeamLargePRs\x12$.prservice.v1.SetTeamLargePRsRequest\x1a%.prservice.v1.SetTeamLargePRsResponse\x12R\n" +
+	"\fSetTeamRules\x12!.prservice.v1.SetTeamRulesRequest\x1a\x1f.prservice.v1.TeamRulesResponse\x12R\n" +
+	"\fGetTeamRules\x12!.prservice.v1.GetTeamRulesRequest\x1a\x1f.prservice.v1.TeamRulesResponse\x12U\n" +
+	"\rSetRepository\x12\".prservice.v1.SetRepositoryRequest\x1a .prservice.v1.RepositoryResponse\x12a\n" +
+	"\x10ListRepositories\x12%.prservice.v1.ListRepositoriesRequest\x1a&.prservice.v1.ListRepositoriesResponse\x12F\n" +
+	"\aGetUser\x12\x1c.prservice.v1.GetUserRequest\x1a\x1d.prservice.v1.GetUserResponse\x12L\n" +
+	"\tListUsers\x12\x1e.prservice.v1.ListUsersRequest\x1a\x1f.prservice.v1.ListUsersResponse\x12O\n" +
+	"\rSetUserActive\x12\".prservice.v1.SetUserActiveRequest\x1a\x1a.prservice.v1.UserResponse\x12R\n" +
+	"\vSetUserTags\x12 .prservice.v1.SetUserTagsRequest\x1a!.prservice.v1.SetUserTagsResponse\x12a\n" +
+	"\x10SetUserSeniority\x12%.prservice.v1.SetUserSeniorityRequest\x1a&.prservice.v1.SetUserSeniorityResponse\x12I\n" +
+	"\bMoveUser\x12\x1d.prservice.v1.MoveUserRequest\x1a\x1e.prservice.v1.MoveUserResponse\x12[\n" +
+	"\x0eGetUserReviews\x12#.prservice.v1.GetUserReviewsRequest\x1a$.prservice.v1.GetUserReviewsResponse\x12^\n" +
+	"\x11CreatePullRequest\x12&.prservice.v1.CreatePullRequestRequest\x1a!.prservice.v1.PullRequestResponse\x12X\n" +
+	"\x0eGetPullRequest\x12#.prservice.v1.GetPullRequestRequest\x1a!.prservice.v1.PullRequestResponse\x12a\n" +
+	"\x10ListPullRequests\x12%.prservice.v1.ListPullRequestsRequest\x1a&.prservice.v1.ListPullRequestsResponse\x12\\\n" +
+	"\x10ReadyPullRequest\x12%.prservice.v1.ReadyPullRequestRequest\x1a!.prservice.v1.PullRequestResponse\x12\\\n" +
+	"\x10MergePullRequest\x12%.prservice.v1.MergePullRequestRequest\x1a!.prservice.v1.PullRequestResponse\x12a\n" +
+	"\x10ReassignReviewer\x12%.prservice.v1.ReassignReviewerRequest\x1a&.prservice.v1.ReassignReviewerResponseB\\ZZgithub.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/pb/prservice/v1;prservicev1b\x06proto3"
+
+var (
+	file_prservice_v1_prservice_proto_rawDescOnce sync.Once
+	file_prservice_v1_prservice_proto_rawDescData []byte
+)
+
+func file_prservice_v1_prservice_proto_rawDescGZIP() []byte {
+	file_prservice_v1_prservice_proto_rawDescOnce.Do(func() {
+		file_prservice_v1_prservice_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_prservice_v1_prservice_proto_rawDesc), len(file_prservice_v1_prservice_proto_rawDesc)))
+	})
+	return file_prservice_v1_prservice_proto_rawDescData
+}
+
+var file_prservice_v1_prservice_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_prservice_v1_prservice_proto_msgTypes = make([]protoimpl.MessageInfo, 57)
+var file_prservice_v1_prservice_proto_goTypes = []any{
+	(PullRequestStatus)(0),            // 0: prservice.v1.PullRequestStatus
+	(*Member)(nil),                    // 1: prservice.v1.Member
+	(*Team)(nil),                      // 2: prservice.v1.Team
+	(*User)(nil),                      // 3: prservice.v1.User
+	(*StringList)(nil),                // 4: prservice.v1.StringList
+	(*PullRequest)(nil),               // 5: prservice.v1.PullRequest
+	(*Exclusion)(nil),                 // 6: prservice.v1.Exclusion
+	(*ReviewerExplanation)(nil),       // 7: prservice.v1.ReviewerExplanation
+	(*PullRequestShort)(nil),          // 8: prservice.v1.PullRequestShort
+	(*TimeRange)(nil),                 // 9: prservice.v1.TimeRange
+	(*Page)(nil),                      // 10: prservice.v1.Page
+	(*CreateTeamRequest)(nil),         // 11: prservice.v1.CreateTeamRequest
+	(*GetTeamRequest)(nil),            // 12: prservice.v1.GetTeamRequest
+	(*UpdateTeamRequest)(nil),         // 13: prservice.v1.UpdateTeamRequest
+	(*RemoveTeamMemberRequest)(nil),   // 14: prservice.v1.RemoveTeamMemberRequest
+	(*TeamResponse)(nil),              // 15: prservice.v1.TeamResponse
+	(*DeleteTeamRequest)(nil),         // 16: prservice.v1.DeleteTeamRequest
+	(*DeleteTeamResponse)(nil),        // 17: prservice.v1.DeleteTeamResponse
+	(*SetTeamCodeownersRequest)(nil),  // 18: prservice.v1.SetTeamCodeownersRequest
+	(*CodeownersRule)(nil),            // 19: prservice.v1.CodeownersRule
+	(*SetTeamCodeownersResponse)(nil), // 20: prservice.v1.SetTeamCodeownersResponse
+	(*SetTeamMentorshipRequest)(nil),  // 21: prservice.v1.SetTeamMentorshipRequest
+	(*SetTeamMentorshipResponse)(nil), // 22: prservice.v1.SetTeamMentorshipResponse
+	(*SetTeamLargePRsRequest)(nil),    // 23: prservice.v1.SetTeamLargePRsRequest
+	(*SetTeamLargePRsResponse)(nil),   // 24: prservice.v1.SetTeamLargePRsResponse
+	(*PairRule)(nil),                  // 25: prservice.v1.PairRule
+	(*SetTeamRulesRequest)(nil),       // 26: prservice.v1.SetTeamRulesRequest
+	(*GetTeamRulesRequest)(nil),       // 27: prservice.v1.GetTeamRulesRequest
+	(*TeamRulesResponse)(nil),         // 28: prservice.v1.TeamRulesResponse
+	(*Repository)(nil),                // 29: prservice.v1.Repository
+	(*SetRepositoryRequest)(nil),      // 30: prservice.v1.SetRepositoryRequest
+	(*RepositoryResponse)(nil),        // 31: prservice.v1.RepositoryResponse
+	(*ListRepositoriesRequest)(nil),   // 32: prservice.v1.ListRepositoriesRequest
+	(*ListRepositoriesResponse)(nil),  // 33: prservice.v1.ListRepositoriesResponse
+	(*GetUserRequest)(nil),            // 34: prservice.v1.GetUserRequest
+	(*GetUserResponse)(nil),           // 35: prservice.v1.GetUserResponse
+	(*ListUsersRequest)(nil),          // 36: prservice.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),         // 37: prservice.v1.ListUsersResponse
+	(*SetUserActiveRequest)(nil),      // 38: prservice.v1.SetUserActiveRequest
+	(*UserResponse)(nil),              // 39: prservice.v1.UserResponse
+	(*SetUserTagsRequest)(nil),        // 40: prservice.v1.SetUserTagsRequest
+	(*SetUserTagsResponse)(nil),       // 41: prservice.v1.SetUserTagsResponse
+	(*SetUserSeniorityRequest)(nil),   // 42: prservice.v1.SetUserSeniorityRequest
+	(*SetUserSeniorityResponse)(nil),  // 43: prservice.v1.SetUserSeniorityResponse
+	(*MoveUserRequest)(nil),           // 44: prservice.v1.MoveUserRequest
+	(*MoveUserResponse)(nil),          // 45: prservice.v1.MoveUserResponse
+	(*GetUserReviewsRequest)(nil),     // 46: prservice.v1.GetUserReviewsRequest
+	(*GetUserReviewsResponse)(nil),    // 47: prservice.v1.GetUserReviewsResponse
+	(*CreatePullRequestRequest)(nil),  // 48: prservice.v1.CreatePullRequestRequest
+	(*GetPullRequestRequest)(nil),     // 49: prservice.v1.GetPullRequestRequest
+	(*PullRequestResponse)(nil),       // 50: prservice.v1.PullRequestResponse
+	(*ListPullRequestsRequest)(nil),   // 51: prservice.v1.ListPullRequestsRequest
+	(*ListPullRequestsResponse)(nil),  // 52: prservice.v1.ListPullRequestsResponse
+	(*ReadyPullRequestRequest)(nil),   // 53: prservice.v1.ReadyPullRequestRequest
+	(*MergePullRequestRequest)(nil),   // 54: prservice.v1.MergePullRequestRequest
+	(*ReassignReviewerRequest)(nil),   // 55: prservice.v1.ReassignReviewerRequest
+	(*ReassignReviewerResponse)(nil),  // 56: prservice.v1.ReassignReviewerResponse
+	nil,                               // 57: prservice.v1.PullRequest.ReviewerTagsEntry
+	(*timestamppb.Timestamp)(nil),     // 58: google.protobuf.Timestamp
+}
+var file_prservice_v1_prservice_proto_depIdxs = []int32{
+	1,  // 0: prservice.v1.Team.members:type_name -> prservice.v1.Member
+	0,  // 1: prservice.v1.PullRequest.status:type_name -> prservice.v1.PullRequestStatus
+	57, // 2: prservice.v1.PullRequest.reviewer_tags:type_name -> prservice.v1.PullRequest.ReviewerTagsEntry
+	58, // 3: prservice.v1.PullRequest.created_at:type_name -> google.protobuf.Timestamp
+	58, // 4: prservice.v1.PullRequest.merged_at:type_name -> google.protobuf.Timestamp
+	7,  // 5: prservice.v1.PullRequest.explanations:type_name -> prservice.v1.ReviewerExplanation
+	6,  // 6: prservice.v1.ReviewerExplanation.excluded:type_name -> prservice.v1.Exclusion
+	58, // 7: prservice.v1.ReviewerExplanation.chosen_at:type_name -> google.protobuf.Timestamp
+	0,  // 8: prservice.v1.PullRequestShort.status:type_name -> prservice.v1.PullRequestStatus
+	58, // 9: prservice.v1.PullRequestShort.created_at:type_name -> google.protobuf.Timestamp
+	58, // 10: prservice.v1.TimeRange.created_after:type_name -> google.protobuf.Timestamp
+	58, // 11: prservice.v1.TimeRange.created_before:type_name -> google.protobuf.Timestamp
+	2,  // 12: prservice.v1.CreateTeamRequest.team:type_name -> prservice.v1.Team
+	2,  // 13: prservice.v1.UpdateTeamRequest.team:type_name -> prservice.v1.Team
+	2,  // 14: prservice.v1.TeamResponse.team:type_name -> prservice.v1.Team
+	19, // 15: prservice.v1.SetTeamCodeownersResponse.rules:type_name -> prservice.v1.CodeownersRule
+	25, // 16: prservice.v1.SetTeamRulesRequest.rules:type_name -> prservice.v1.PairRule
+	25, // 17: prservice.v1.TeamRulesResponse.rules:type_name -> prservice.v1.PairRule
+	29, // 18: prservice.v1.SetRepositoryRequest.repository:type_name -> prservice.v1.Repository
+	29, // 19: prservice.v1.RepositoryResponse.repository:type_name -> prservice.v1.Repository
+	29, // 20: prservice.v1.ListRepositoriesResponse.repositories:type_name -> prservice.v1.Repository
+	3,  // 21: prservice.v1.GetUserResponse.user:type_name -> prservice.v1.User
+	3,  // 22: prservice.v1.ListUsersResponse.users:type_name -> prservice.v1.User
+	3,  // 23: prservice.v1.UserResponse.user:type_name -> prservice.v1.User
+	3,  // 24: prservice.v1.MoveUserResponse.user:type_name -> prservice.v1.User
+	0,  // 25: prservice.v1.GetUserReviewsRequest.status:type_name -> prservice.v1.PullRequestStatus
+	9,  // 26: prservice.v1.GetUserReviewsRequest.created:type_name -> prservice.v1.TimeRange
+	10, // 27: prservice.v1.GetUserReviewsRequest.page:type_name -> prservice.v1.Page
+	8,  // 28: prservice.v1.GetUserReviewsResponse.pull_requests:type_name -> prservice.v1.PullRequestShort
+	5,  // 29: prservice.v1.PullRequestResponse.pr:type_name -> prservice.v1.PullRequest
+	0,  // 30: prservice.v1.ListPullRequestsRequest.status:type_name -> prservice.v1.PullRequestStatus
+	9,  // 31: prservice.v1.ListPullRequestsRequest.created:type_name -> prservice.v1.TimeRange
+	10, // 32: prservice.v1.ListPullRequestsRequest.page:type_name -> prservice.v1.Page
+	5,  // 33: prservice.v1.ListPullRequestsResponse.pull_requests:type_name -> prservice.v1.PullRequest
+	5,  // 34: prservice.v1.ReassignReviewerResponse.pr:type_name -> prservice.v1.PullRequest
+	4,  // 35: prservice.v1.PullRequest.ReviewerTagsEntry.value:type_name -> prservice.v1.StringList
+	11, // 36: prservice.v1.PRService.CreateTeam:input_type -> prservice.v1.CreateTeamRequest
+	12, // 37: prservice.v1.PRService.GetTeam:input_type -> prservice.v1.GetTeamRequest
+	13, // 38: prservice.v1.PRService.UpdateTeam:input_type -> prservice.v1.UpdateTeamRequest
+	14, // 39: prservice.v1.PRService.RemoveTeamMember:input_type -> prservice.v1.RemoveTeamMemberRequest
+	16, // 40: prservice.v1.PRService.DeleteTeam:input_type -> prservice.v1.DeleteTeamRequest
+	18, // 41: prservice.v1.PRService.SetTeamCodeowners:input_type -> prservice.v1.SetTeamCodeownersRequest
+	21, // 42: prservice.v1.PRService.SetTeamMentorship:input_type -> prservice.v1.SetTeamMentorshipRequest
+	23, // 43: prservice.v1.PRService.SetTeamLargePRs:input_type -> prservice.v1.SetTeamLargePRsRequest
+	26, // 44: prservice.v1.PRService.SetTeamRules:input_type -> prservice.v1.SetTeamRulesRequest
+	27, // 45: prservice.v1.PRService.GetTeamRules:input_type -> prservice.v1.GetTeamRulesRequest
+	30, // 46: prservice.v1.PRService.SetRepository:input_type -> prservice.v1.SetRepositoryRequest
+	32, // 47: prservice.v1.PRService.ListRepositories:input_type -> prservice.v1.ListRepositoriesRequest
+	34, // 48: prservice.v1.PRService.GetUser:input_type -> prservice.v1.GetUserRequest
+	36, // 49: prservice.v1.PRService.ListUsers:input_type -> prservice.v1.ListUsersRequest
+	38, // 50: prservice.v1.PRService.SetUserActive:input_type -> prservice.v1.SetUserActiveRequest
+	40, // 51: prservice.v1.PRService.SetUserTags:input_type -> prservice.v1.SetUserTagsRequest
+	42, // 52: prservice.v1.PRService.SetUserSeniority:input_type -> prservice.v1.SetUserSeniorityRequest
+	44, // 53: prservice.v1.PRService.MoveUser:input_type -> prservice.v1.MoveUserRequest
+	46, // 54: prservice.v1.PRService.GetUserReviews:input_type -> prservice.v1.GetUserReviewsRequest
+	48, // 55: prservice.v1.PRService.CreatePullRequest:input_type -> prservice.v1.CreatePullRequestRequest
+	49, // 56: prservice.v1.PRService.GetPullRequest:input_type -> prservice.v1.GetPullRequestRequest
+	51, // 57: prservice.v1.PRService.ListPullRequests:input_type -> prservice.v1.ListPullRequestsRequest
+	53, // 58: prservice.v1.PRService.ReadyPullRequest:input_type -> prservice.v1.ReadyPullRequestRequest
+	54, // 59: prservice.v1.PRService.MergePullRequest:input_type -> prservice.v1.MergePullRequestRequest
+	55, // 60: prservice.v1.PRService.ReassignReviewer:input_type -> prservice.v1.ReassignReviewerRequest
+	15, // 61: prservice.v1.PRService.CreateTeam:output_type -> prservice.v1.TeamResponse
+	15, // 62: prservice.v1.PRService.GetTeam:output_type -> prservice.v1.TeamResponse
+	15, // 63: prservice.v1.PRService.UpdateTeam:output_type -> prservice.v1.TeamResponse
+	15, // 64: prservice.v1.PRService.RemoveTeamMember:output_type -> prservice.v1.TeamResponse
+	17, // 65: prservice.v1.PRService.DeleteTeam:output_type -> prservice.v1.DeleteTeamResponse
+	20, // 66: prservice.v1.PRService.SetTeamCodeowners:output_type -> prservice.v1.SetTeamCodeownersResponse
+	22, // 67: prservice.v1.PRService.SetTeamMentorship:output_type -> prservice.v1.SetTeamMentorshipResponse
+	24, // 68: prservice.v1.PRService.SetTeamLargePRs:output_type -> prservice.v1.SetTeamLargePRsResponse
+	28, // 69: prservice.v1.PRService.SetTeamRules:output_type -> prservice.v1.TeamRulesResponse
+	28, // 70: prservice.v1.PRService.GetTeamRules:output_type -> prservice.v1.TeamRulesResponse
+	31, // 71: prservice.v1.PRService.SetRepository:output_type -> prservice.v1.RepositoryResponse
+	33, // 72: prservice.v1.PRService.ListRepositories:output_type -> prservice.v1.ListRepositoriesResponse
+	35, // 73: prservice.v1.PRService.GetUser:output_type -> prservice.v1.GetUserResponse
+	37, // 74: prservice.v1.PRService.ListUsers:output_type -> prservice.v1.ListUsersResponse
+	39, // 75: prservice.v1.PRService.SetUserActive:output_type -> prservice.v1.UserResponse
+	41, // 76: prservice.v1.PRService.SetUserTags:output_type -> prservice.v1.SetUserTagsResponse
+	43, // 77: prservice.v1.PRService.SetUserSeniority:output_type -> prservice.v1.SetUserSeniorityResponse
+	45, // 78: prservice.v1.PRService.MoveUser:output_type -> prservice.v1.MoveUserResponse
+	47, // 79: prservice.v1.PRService.GetUserReviews:output_type -> prservice.v1.GetUserReviewsResponse
+	50, // 80: prservice.v1.PRService.CreatePullRequest:output_type -> prservice.v1.PullRequestResponse
+	50, // 81: prservice.v1.PRService.GetPullRequest:output_type -> prservice.v1.PullRequestResponse
+	52, // 82: prservice.v1.PRService.ListPullRequests:output_type -> prservice.v1.ListPullRequestsResponse
+	50, // 83: prservice.v1.PRService.ReadyPullRequest:output_type -> prservice.v1.PullRequestResponse
+	50, // 84: prservice.v1.PRService.MergePullRequest:output_type -> prservice.v1.PullRequestResponse
+	56, // 85: prservice.v1.PRService.ReassignReviewer:output_type -> prservice.v1.ReassignReviewerResponse
+	61, // [61:86] is the sub-list for method output_type
+	36, // [36:61] is the sub-list for method input_type
+	36, // [36:36] is the sub-list for extension type_name
+	36, // [36:36] is the sub-list for extension extendee
+	0,  // [0:36] is the sub-list for field type_name
+}
+
+func init() { file_prservice_v1_prservice_proto_init() }
+func file_prservice_v1_prservice_proto_init() {
+	if File_prservice_v1_prservice_proto != nil {
+		return
+	}
+	file_prservice_v1_prservice_proto_msgTypes[35].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_prservice_v1_prservice_proto_rawDesc), len(file_prservice_v1_prservice_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   57,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_prservice_v1_prservice_proto_goTypes,
+		DependencyIndexes: file_prservice_v1_prservice_proto_depIdxs,
+		EnumInfos:         file_prservice_v1_prservice_proto_enumTypes,
+		MessageInfos:      file_prservice_v1_prservice_proto_msgTypes,
+	}.Build()
+	File_prservice_v1_prservice_proto = out.File
+	file_prservice_v1_prservice_proto_goTypes = nil
+	file_prservice_v1_prservice_proto_depIdxs = nil
+}
@@ -0,0 +1,1037 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.28.3
+// source: prservice/v1/prservice.proto
+
+package prservicev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PRService_CreateTeam_FullMethodName        = "/prservice.v1.PRService/CreateTeam"
+	PRService_GetTeam_FullMethodName           = "/prservice.v1.PRService/GetTeam"
+	PRService_UpdateTeam_FullMethodName        = "/prservice.v1.PRService/UpdateTeam"
+	PRService_RemoveTeamMember_FullMethodName  = "/prservice.v1.PRService/RemoveTeamMember"
+	PRService_DeleteTeam_FullMethodName        = "/prservice.v1.PRService/DeleteTeam"
+	PRService_SetTeamCodeowners_FullMethodName = "/prservice.v1.PRService/SetTeamCodeowners"
+	PRService_SetTeamMentorship_FullMethodName = "/prservice.v1.PRService/SetTeamMentorship"
+	PRService_SetTeamLargePRs_FullMethodName   = "/prservice.v1.PRService/SetTeamLargePRs"
+	PRService_SetTeamRules_FullMethodName      = "/prservice.v1.PRService/SetTeamRules"
+	PRService_GetTeamRules_FullMethodName      = "/prservice.v1.PRService/GetTeamRules"
+	PRService_SetRepository_FullMethodName     = "/prservice.v1.PRService/SetRepository"
+	PRService_ListRepositories_FullMethodName  = "/prservice.v1.PRService/ListRepositories"
+	PRService_GetUser_FullMethodName           = "/prservice.v1.PRService/GetUser"
+	PRService_ListUsers_FullMethodName         = "/prservice.v1.PRService/ListUsers"
+	PRService_SetUserActive_FullMethodName     = "/prservice.v1.PRService/SetUserActive"
+	PRService_SetUserTags_FullMethodName       = "/prservice.v1.PRService/SetUserTags"
+	PRService_SetUserSeniority_FullMethodName  = "/prservice.v1.PRService/SetUserSeniority"
+	PRService_MoveUser_FullMethodName          = "/prservice.v1.PRService/MoveUser"
+	PRService_GetUserReviews_FullMethodName    = "/prservice.v1.PRService/GetUserReviews"
+	PRService_CreatePullRequest_FullMethodName = "/prservice.v1.PRService/CreatePullRequest"
+	PRService_GetPullRequest_FullMethodName    = "/prservice.v1.PRService/GetPullRequest"
+	PRService_ListPullRequests_FullMethodName  = "/prservice.v1.PRService/ListPullRequests"
+	PRService_ReadyPullRequest_FullMethodName  = "/prservice.v1.PRService/ReadyPullRequest"
+	PRService_MergePullRequest_FullMethodName  = "/prservice.v1.PRService/MergePullRequest"
+	PRService_ReassignReviewer_FullMethodName  = "/prservice.v1.PRService/ReassignReviewer"
+)
+
+// PRServiceClient is the client API for PRService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PRService mirrors the HTTP API: teams, users, pull requests and reviews.
+type PRServiceClient interface {
+	CreateTeam(ctx context.Context, in *CreateTeamRequest, opts ...grpc.CallOption) (*TeamResponse, error)
+	GetTeam(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*TeamResponse, error)
+	UpdateTeam(ctx context.Context, in *UpdateTeamRequest, opts ...grpc.CallOption) (*TeamResponse, error)
+	RemoveTeamMember(ctx context.Context, in *RemoveTeamMemberRequest, opts ...grpc.CallOption) (*TeamResponse, error)
+	DeleteTeam(ctx context.Context, in *DeleteTeamRequest, opts ...grpc.CallOption) (*DeleteTeamResponse, error)
+	SetTeamCodeowners(ctx context.Context, in *SetTeamCodeownersRequest, opts ...grpc.CallOption) (*SetTeamCodeownersResponse, error)
+	SetTeamMentorship(ctx context.Context, in *SetTeamMentorshipRequest, opts ...grpc.CallOption) (*SetTeamMentorshipResponse, error)
+	SetTeamLargePRs(ctx context.Context, in *SetTeamLargePRsRequest, opts ...grpc.CallOption) (*SetTeamLargePRsResponse, error)
+	SetTeamRules(ctx context.Context, in *SetTeamRulesRequest, opts ...grpc.CallOption) (*TeamRulesResponse, error)
+	GetTeamRules(ctx context.Context, in *GetTeamRulesRequest, opts ...grpc.CallOption) (*TeamRulesResponse, error)
+	SetRepository(ctx context.Context, in *SetRepositoryRequest, opts ...grpc.CallOption) (*RepositoryResponse, error)
+	ListRepositories(ctx context.Context, in *ListRepositoriesRequest, opts ...grpc.CallOption) (*ListRepositoriesResponse, error)
+	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	SetUserActive(ctx context.Context, in *SetUserActiveRequest, opts ...grpc.CallOption) (*UserResponse, error)
+	SetUserTags(ctx context.Context, in *SetUserTagsRequest, opts ...grpc.CallOption) (*SetUserTagsResponse, error)
+	SetUserSeniority(ctx context.Context, in *SetUserSeniorityRequest, opts ...grpc.CallOption) (*SetUserSeniorityResponse, error)
+	MoveUser(ctx context.Context, in *MoveUserRequest, opts ...grpc.CallOption) (*MoveUserResponse, error)
+	GetUserReviews(ctx context.Context, in *GetUserReviewsRequest, opts ...grpc.CallOption) (*GetUserReviewsResponse, error)
+	CreatePullRequest(ctx context.Context, in *CreatePullRequestRequest, opts ...grpc.CallOption) (*PullRequestResponse, error)
+	GetPullRequest(ctx context.Context, in *GetPullRequestRequest, opts ...grpc.CallOption) (*PullRequestResponse, error)
+	ListPullRequests(ctx context.Context, in *ListPullRequestsRequest, opts ...grpc.CallOption) (*ListPullRequestsResponse, error)
+	ReadyPullRequest(ctx context.Context, in *ReadyPullRequestRequest, opts ...grpc.CallOption) (*PullRequestResponse, error)
+	MergePullRequest(ctx context.Context, in *MergePullRequestRequest, opts ...grpc.CallOption) (*PullRequestResponse, error)
+	ReassignReviewer(ctx context.Context, in *ReassignReviewerRequest, opts ...grpc.CallOption) (*ReassignReviewerResponse, error)
+}
+
+type pRServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPRServiceClient(cc grpc.ClientConnInterface) PRServiceClient {
+	return &pRServiceClient{cc}
+}
+
+func (c *pRServiceClient) CreateTeam(ctx context.Context, in *CreateTeamRequest, opts ...grpc.CallOption) (*TeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TeamResponse)
+	err := c.cc.Invoke(ctx, PRService_CreateTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRServiceClient) GetTeam(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*TeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TeamResponse)
+	err := c.cc.Invoke(ctx, PRService_GetTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRServiceClient) UpdateTeam(ctx context.Context, in *UpdateTeamRequest, opts ...grpc.CallOption) (*TeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TeamResponse)
+	err := c.cc.Invoke(ctx, PRService_UpdateTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRServiceClient) RemoveTeamMember(ctx context.Context, in *RemoveTeamMemberRequest, opts ...grpc.CallOption) (*TeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TeamResponse)
+	err := c.cc.Invoke(ctx, PRService_RemoveTeamMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRServiceClient) DeleteTeam(ctx context.Context, in *DeleteTeamRequest, opts ...grpc.CallOption) (*DeleteTeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTeamResponse)
+	err := c.cc.Invoke(ctx, PRService_DeleteTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRServiceClient) SetTeamCodeowners(ctx context.Context, in *SetTeamCodeownersRequest, opts ...grpc.CallOption) (*SetTeamCodeownersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTeamCodeownersResponse)
+	err := c.cc.Invoke(ctx, PRService_SetTeamCodeowners_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRServiceClient) SetTeamMentorship(ctx context.Context, in *SetTeamMentorshipRequest, opts ...grpc.CallOption) (*SetTeamMentorshipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTeamMentorshipResponse)
+	err := c.cc.Invoke(ctx, PRService_SetTeamMentorship_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRServiceClient) SetTeamLargePRs(ctx context.Context, in *SetTeamLargePRsRequest, opts ...grpc.CallOption) (*SetTeamLargePRsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTeamLargePRsResponse)
+	err := c.cc.Invoke(ctx, PRService_SetTeamLargePRs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRServiceClient) SetTeamRules(ctx context.Context, in *SetTeamRulesRequest, opts ...grpc.CallOption) (*TeamRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TeamRulesResponse)
+	err := c.cc.Invoke(ctx, PRService_SetTeamRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRServiceClient) GetTeamRules(ctx context.Context, in *GetTeamRulesRequest, opts ...grpc.CallOption) (*TeamRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TeamRulesResponse)
+	err := c.cc.Invoke(ctx, PRService_GetTeamRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRServiceClient) SetRepository(ctx context.Context, in *SetRepositoryRequest, opts ...grpc.CallOption) (*RepositoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RepositoryResponse)
+	err := c.cc.Invoke(ctx, PRService_SetRepository_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRServiceClient) ListRepositories(ctx context.Context, in *ListRepositoriesRequest, opts ...grpc.CallOption) (*ListRepositoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRepositoriesResponse)
+	err := c.cc.Invoke(ctx, PRService_ListRepositories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRServiceClient) GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserResponse)
+	err := c.cc.Invoke(ctx, PRService_GetUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRServiceClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUsersResponse)
+	err := c.cc.Invoke(ctx, PRService_ListUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRServiceClient) SetUserActive(ctx context.Context, in *SetUserActiveRequest, opts ...grpc.CallOption) (*UserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, PRService_SetUserActive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRServiceClient) SetUserTags(ctx context.Context, in *SetUserTagsRequest, opts ...grpc.CallOption) (*SetUserTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetUserTagsResponse)
+	err := c.cc.Invoke(ctx, PRService_SetUserTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRServiceClient) SetUserSeniority(ctx context.Context, in *SetUserSeniorityRequest, opts ...grpc.CallOption) (*SetUserSeniorityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetUserSeniorityResponse)
+	err := c.cc.Invoke(ctx, PRService_SetUserSeniority_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRServiceClient) MoveUser(ctx context.Context, in *MoveUserRequest, opts ...grpc.CallOption) (*MoveUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveUserResponse)
+	err := c.cc.Invoke(ctx, PRService_MoveUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRServiceClient) GetUserReviews(ctx context.Context, in *GetUserReviewsRequest, opts ...grpc.CallOption) (*GetUserReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserReviewsResponse)
+	err := c.cc.Invoke(ctx, PRService_GetUserReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRServiceClient) CreatePullRequest(ctx context.Context, in *CreatePullRequestRequest, opts ...grpc.CallOption) (*PullRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PullRequestResponse)
+	err := c.cc.Invoke(ctx, PRService_CreatePullRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRServiceClient) GetPullRequest(ctx context.Context, in *GetPullRequestRequest, opts ...grpc.CallOption) (*PullRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PullRequestResponse)
+	err := c.cc.Invoke(ctx, PRService_GetPullRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRServiceClient) ListPullRequests(ctx context.Context, in *ListPullRequestsRequest, opts ...grpc.CallOption) (*ListPullRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPullRequestsResponse)
+	err := c.cc.Invoke(ctx, PRService_ListPullRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRServiceClient) ReadyPullRequest(ctx context.Context, in *ReadyPullRequestRequest, opts ...grpc.CallOption) (*PullRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PullRequestResponse)
+	err := c.cc.Invoke(ctx, PRService_ReadyPullRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRServiceClient) MergePullRequest(ctx context.Context, in *MergePullRequestRequest, opts ...grpc.CallOption) (*PullRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PullRequestResponse)
+	err := c.cc.Invoke(ctx, PRService_MergePullRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRServiceClient) ReassignReviewer(ctx context.Context, in *ReassignReviewerRequest, opts ...grpc.CallOption) (*ReassignReviewerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReassignReviewerResponse)
+	err := c.cc.Invoke(ctx, PRService_ReassignReviewer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PRServiceServer is the server API for PRService service.
+// All implementations must embed UnimplementedPRServiceServer
+// for forward compatibility.
+//
+// PRService mirrors the HTTP API: teams, users, pull requests and reviews.
+type PRServiceServer interface {
+	CreateTeam(context.Context, *CreateTeamRequest) (*TeamResponse, error)
+	GetTeam(context.Context, *GetTeamRequest) (*TeamResponse, error)
+	UpdateTeam(context.Context, *UpdateTeamRequest) (*TeamResponse, error)
+	RemoveTeamMember(context.Context, *RemoveTeamMemberRequest) (*TeamResponse, error)
+	DeleteTeam(context.Context, *DeleteTeamRequest) (*DeleteTeamResponse, error)
+	SetTeamCodeowners(context.Context, *SetTeamCodeownersRequest) (*SetTeamCodeownersResponse, error)
+	SetTeamMentorship(context.Context, *SetTeamMentorshipRequest) (*SetTeamMentorshipResponse, error)
+	SetTeamLargePRs(context.Context, *SetTeamLargePRsRequest) (*SetTeamLargePRsResponse, error)
+	SetTeamRules(context.Context, *SetTeamRulesRequest) (*TeamRulesResponse, error)
+	GetTeamRules(context.Context, *GetTeamRulesRequest) (*TeamRulesResponse, error)
+	SetRepository(context.Context, *SetRepositoryRequest) (*RepositoryResponse, error)
+	ListRepositories(context.Context, *ListRepositoriesRequest) (*ListRepositoriesResponse, error)
+	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
+	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	SetUserActive(context.Context, *SetUserActiveRequest) (*UserResponse, error)
+	SetUserTags(context.Context, *SetUserTagsRequest) (*SetUserTagsResponse, error)
+	SetUserSeniority(context.Context, *SetUserSeniorityRequest) (*SetUserSeniorityResponse, error)
+	MoveUser(context.Context, *MoveUserRequest) (*MoveUserResponse, error)
+	GetUserReviews(context.Context, *GetUserReviewsRequest) (*GetUserReviewsResponse, error)
+	CreatePullRequest(context.Context, *CreatePullRequestRequest) (*PullRequestResponse, error)
+	GetPullRequest(context.Context, *GetPullRequestRequest) (*PullRequestResponse, error)
+	ListPullRequests(context.Context, *ListPullRequestsRequest) (*ListPullRequestsResponse, error)
+	ReadyPullRequest(context.Context, *ReadyPullRequestRequest) (*PullRequestResponse, error)
+	MergePullRequest(context.Context, *MergePullRequestRequest) (*PullRequestResponse, error)
+	ReassignReviewer(context.Context, *ReassignReviewerRequest) (*ReassignReviewerResponse, error)
+	mustEmbedUnimplementedPRServiceServer()
+}
+
+// UnimplementedPRServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPRServiceServer struct{}
+
+func (UnimplementedPRServiceServer) CreateTeam(context.Context, *CreateTeamRequest) (*TeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTeam not implemented")
+}
+func (UnimplementedPRServiceServer) GetTeam(context.Context, *GetTeamRequest) (*TeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeam not implemented")
+}
+func (UnimplementedPRServiceServer) UpdateTeam(context.Context, *UpdateTeamRequest) (*TeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTeam not implemented")
+}
+func (UnimplementedPRServiceServer) RemoveTeamMember(context.Context, *RemoveTeamMemberRequest) (*TeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTeamMember not implemented")
+}
+func (UnimplementedPRServiceServer) DeleteTeam(context.Context, *DeleteTeamRequest) (*DeleteTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTeam not implemented")
+}
+func (UnimplementedPRServiceServer) SetTeamCodeowners(context.Context, *SetTeamCodeownersRequest) (*SetTeamCodeownersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTeamCodeowners not implemented")
+}
+func (UnimplementedPRServiceServer) SetTeamMentorship(context.Context, *SetTeamMentorshipRequest) (*SetTeamMentorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTeamMentorship not implemented")
+}
+func (UnimplementedPRServiceServer) SetTeamLargePRs(context.Context, *SetTeamLargePRsRequest) (*SetTeamLargePRsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTeamLargePRs not implemented")
+}
+func (UnimplementedPRServiceServer) SetTeamRules(context.Context, *SetTeamRulesRequest) (*TeamRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTeamRules not implemented")
+}
+func (UnimplementedPRServiceServer) GetTeamRules(context.Context, *GetTeamRulesRequest) (*TeamRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeamRules not implemented")
+}
+func (UnimplementedPRServiceServer) SetRepository(context.Context, *SetRepositoryRequest) (*RepositoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRepository not implemented")
+}
+func (UnimplementedPRServiceServer) ListRepositories(context.Context, *ListRepositoriesRequest) (*ListRepositoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRepositories not implemented")
+}
+func (UnimplementedPRServiceServer) GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedPRServiceServer) ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (UnimplementedPRServiceServer) SetUserActive(context.Context, *SetUserActiveRequest) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserActive not implemented")
+}
+func (UnimplementedPRServiceServer) SetUserTags(context.Context, *SetUserTagsRequest) (*SetUserTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserTags not implemented")
+}
+func (UnimplementedPRServiceServer) SetUserSeniority(context.Context, *SetUserSeniorityRequest) (*SetUserSeniorityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserSeniority not implemented")
+}
+func (UnimplementedPRServiceServer) MoveUser(context.Context, *MoveUserRequest) (*MoveUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveUser not implemented")
+}
+func (UnimplementedPRServiceServer) GetUserReviews(context.Context, *GetUserReviewsRequest) (*GetUserReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserReviews not implemented")
+}
+func (UnimplementedPRServiceServer) CreatePullRequest(context.Context, *CreatePullRequestRequest) (*PullRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePullRequest not implemented")
+}
+func (UnimplementedPRServiceServer) GetPullRequest(context.Context, *GetPullRequestRequest) (*PullRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPullRequest not implemented")
+}
+func (UnimplementedPRServiceServer) ListPullRequests(context.Context, *ListPullRequestsRequest) (*ListPullRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPullRequests not implemented")
+}
+func (UnimplementedPRServiceServer) ReadyPullRequest(context.Context, *ReadyPullRequestRequest) (*PullRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadyPullRequest not implemented")
+}
+func (UnimplementedPRServiceServer) MergePullRequest(context.Context, *MergePullRequestRequest) (*PullRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergePullRequest not implemented")
+}
+func (UnimplementedPRServiceServer) ReassignReviewer(context.Context, *ReassignReviewerRequest) (*ReassignReviewerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReassignReviewer not implemented")
+}
+func (UnimplementedPRServiceServer) mustEmbedUnimplementedPRServiceServer() {}
+func (UnimplementedPRServiceServer) testEmbeddedByValue()                   {}
+
+// UnsafePRServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PRServiceServer will
+// result in compilation errors.
+type UnsafePRServiceServer interface {
+	mustEmbedUnimplementedPRServiceServer()
+}
+
+func RegisterPRServiceServer(s grpc.ServiceRegistrar, srv PRServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPRServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PRService_ServiceDesc, srv)
+}
+
+func _PRService_CreateTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRServiceServer).CreateTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRService_CreateTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRServiceServer).CreateTeam(ctx, req.(*CreateTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRService_GetTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRServiceServer).GetTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRService_GetTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRServiceServer).GetTeam(ctx, req.(*GetTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRService_UpdateTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRServiceServer).UpdateTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRService_UpdateTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRServiceServer).UpdateTeam(ctx, req.(*UpdateTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRService_RemoveTeamMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTeamMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRServiceServer).RemoveTeamMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRService_RemoveTeamMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRServiceServer).RemoveTeamMember(ctx, req.(*RemoveTeamMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRService_DeleteTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRServiceServer).DeleteTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRService_DeleteTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRServiceServer).DeleteTeam(ctx, req.(*DeleteTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRService_SetTeamCodeowners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTeamCodeownersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRServiceServer).SetTeamCodeowners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRService_SetTeamCodeowners_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRServiceServer).SetTeamCodeowners(ctx, req.(*SetTeamCodeownersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRService_SetTeamMentorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTeamMentorshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRServiceServer).SetTeamMentorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRService_SetTeamMentorship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRServiceServer).SetTeamMentorship(ctx, req.(*SetTeamMentorshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRService_SetTeamLargePRs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTeamLargePRsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRServiceServer).SetTeamLargePRs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRService_SetTeamLargePRs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRServiceServer).SetTeamLargePRs(ctx, req.(*SetTeamLargePRsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRService_SetTeamRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTeamRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRServiceServer).SetTeamRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRService_SetTeamRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRServiceServer).SetTeamRules(ctx, req.(*SetTeamRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRService_GetTeamRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRServiceServer).GetTeamRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRService_GetTeamRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRServiceServer).GetTeamRules(ctx, req.(*GetTeamRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRService_SetRepository_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRepositoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRServiceServer).SetRepository(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRService_SetRepository_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRServiceServer).SetRepository(ctx, req.(*SetRepositoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRService_ListRepositories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRepositoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRServiceServer).ListRepositories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRService_ListRepositories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRServiceServer).ListRepositories(ctx, req.(*ListRepositoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRServiceServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRService_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRServiceServer).GetUser(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRServiceServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRService_ListUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRServiceServer).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRService_SetUserActive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserActiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRServiceServer).SetUserActive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRService_SetUserActive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRServiceServer).SetUserActive(ctx, req.(*SetUserActiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRService_SetUserTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRServiceServer).SetUserTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRService_SetUserTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRServiceServer).SetUserTags(ctx, req.(*SetUserTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRService_SetUserSeniority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserSeniorityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRServiceServer).SetUserSeniority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRService_SetUserSeniority_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRServiceServer).SetUserSeniority(ctx, req.(*SetUserSeniorityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRService_MoveUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRServiceServer).MoveUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRService_MoveUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRServiceServer).MoveUser(ctx, req.(*MoveUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRService_GetUserReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRServiceServer).GetUserReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRService_GetUserReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRServiceServer).GetUserReviews(ctx, req.(*GetUserReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRService_CreatePullRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePullRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRServiceServer).CreatePullRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRService_CreatePullRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRServiceServer).CreatePullRequest(ctx, req.(*CreatePullRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRService_GetPullRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPullRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRServiceServer).GetPullRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRService_GetPullRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRServiceServer).GetPullRequest(ctx, req.(*GetPullRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRService_ListPullRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPullRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRServiceServer).ListPullRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRService_ListPullRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRServiceServer).ListPullRequests(ctx, req.(*ListPullRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRService_ReadyPullRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadyPullRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRServiceServer).ReadyPullRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRService_ReadyPullRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRServiceServer).ReadyPullRequest(ctx, req.(*ReadyPullRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRService_MergePullRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergePullRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRServiceServer).MergePullRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRService_MergePullRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRServiceServer).MergePullRequest(ctx, req.(*MergePullRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRService_ReassignReviewer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReassignReviewerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRServiceServer).ReassignReviewer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRService_ReassignReviewer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRServiceServer).ReassignReviewer(ctx, req.(*ReassignReviewerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PRService_ServiceDesc is the grpc.ServiceDesc for PRService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PRService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "prservice.v1.PRService",
+	HandlerType: (*PRServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTeam",
+			Handler:    _PRService_CreateTeam_Handler,
+		},
+		{
+			MethodName: "GetTeam",
+			Handler:    _PRService_GetTeam_Handler,
+		},
+		{
+			MethodName: "UpdateTeam",
+			Handler:    _PRService_UpdateTeam_Handler,
+		},
+		{
+			MethodName: "RemoveTeamMember",
+			Handler:    _PRService_RemoveTeamMember_Handler,
+		},
+		{
+			MethodName: "DeleteTeam",
+			Handler:    _PRService_DeleteTeam_Handler,
+		},
+		{
+			MethodName: "SetTeamCodeowners",
+			Handler:    _PRService_SetTeamCodeowners_Handler,
+		},
+		{
+			MethodName: "SetTeamMentorship",
+			Handler:    _PRService_SetTeamMentorship_Handler,
+		},
+		{
+			MethodName: "SetTeamLargePRs",
+			Handler:    _PRService_SetTeamLargePRs_Handler,
+		},
+		{
+			MethodName: "SetTeamRules",
+			Handler:    _PRService_SetTeamRules_Handler,
+		},
+		{
+			MethodName: "GetTeamRules",
+			Handler:    _PRService_GetTeamRules_Handler,
+		},
+		{
+			MethodName: "SetRepository",
+			Handler:    _PRService_SetRepository_Handler,
+		},
+		{
+			MethodName: "ListRepositories",
+			Handler:    _PRService_ListRepositories_Handler,
+		},
+		{
+			MethodName: "GetUser",
+			Handler:    _PRService_GetUser_Handler,
+		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _PRService_ListUsers_Handler,
+		},
+		{
+			MethodName: "SetUserActive",
+			Handler:    _PRService_SetUserActive_Handler,
+		},
+		{
+			MethodName: "SetUserTags",
+			Handler:    _PRService_SetUserTags_Handler,
+		},
+		{
+			MethodName: "SetUserSeniority",
+			Handler:    _PRService_SetUserSeniority_Handler,
+		},
+		{
+			MethodName: "MoveUser",
+			Handler:    _PRService_MoveUser_Handler,
+		},
+		{
+			MethodName: "GetUserReviews",
+			Handler:    _PRService_GetUserReviews_Handler,
+		},
+		{
+			MethodName: "CreatePullRequest",
+			Handler:    _PRService_CreatePullRequest_Handler,
+		},
+		{
+			MethodName: "GetPullRequest",
+			Handler:    _PRService_GetPullRequest_Handler,
+		},
+		{
+			MethodName: "ListPullRequests",
+			Handler:    _PRService_ListPullRequests_Handler,
+		},
+		{
+			MethodName: "ReadyPullRequest",
+			Handler:    _PRService_ReadyPullRequest_Handler,
+		},
+		{
+			MethodName: "MergePullRequest",
+			Handler:    _PRService_MergePullRequest_Handler,
+		},
+		{
+			MethodName: "ReassignReviewer",
+			Handler:    _PRService_ReassignReviewer_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "prservice/v1/prservice.proto",
+}
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *repo) SetTeamCodeowners(ctx context.Context, teamName, content string) error {
+	_, err := r.db.Exec(ctx, `
+		INSERT INTO team_codeowners (team_name, content, updated_at)
+		VALUES ($1, $2, NOW())
+		ON CONFLICT (team_name) DO UPDATE SET content = $2, updated_at = NOW()`,
+		teamName, content)
+	if err != nil {
+		return fmt.Errorf("set codeowners: %w", err)
+	}
+	return nil
+}
+
+// GetTeamCodeowners returns the raw rules file of a team, or an empty string
+// when the team has not uploaded one.
+func (r *repo) GetTeamCodeowners(ctx context.Context, teamName string) (string, error) {
+	var content string
+	err := r.db.QueryRow(ctx,
+		`SELECT content FROM team_codeowners WHERE team_name = $1`, teamName).Scan(&content)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", nil
+		}
+		return "", fmt.Errorf("get codeowners: %w", err)
+	}
+	return content, nil
+}
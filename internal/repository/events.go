@@ -0,0 +1,175 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+)
+
+// EventsChannel is the LISTEN/NOTIFY channel events are fanned out on.
+const EventsChannel = "pr_events"
+
+// eventsAppendLock is the advisory lock key serializing event appends.
+const eventsAppendLock = 0x70725f6576 // "pr_ev"
+
+type eventRepo struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+func NewEventRepository(pool *pgxpool.Pool, logger *slog.Logger) EventRepository {
+	return &eventRepo{pool: pool, logger: logger}
+}
+
+type eventNotification struct {
+	Origin string      `json:"origin"`
+	Event  eventRecord `json:"event"`
+}
+
+type eventRecord struct {
+	ID            int64     `json:"id"`
+	Type          string    `json:"type"`
+	UserID        string    `json:"user_id"`
+	PullRequestID string    `json:"pull_request_id"`
+	AuthorID      string    `json:"author_id"`
+	TeamName      string    `json:"team_name"`
+	ReviewTeam    string    `json:"review_team"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// Append stores the events and notifies listeners in the same transaction,
+// so other replicas only see events that were committed. origin identifies
+// the publishing process and is passed to listeners unchanged.
+func (r *eventRepo) Append(ctx context.Context, origin string, events []models.Event) ([]models.Event, error) {
+	if len(events) == 0 {
+		return nil, nil
+	}
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Ids come from a sequence and are handed out before commit, so two
+	// concurrent appends could commit out of id order and a client resuming
+	// after the higher id would never see the lower one. Holding this lock
+	// until commit makes ids commit-ordered.
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock($1)`, eventsAppendLock); err != nil {
+		return nil, fmt.Errorf("lock events: %w", err)
+	}
+
+	stored := make([]models.Event, 0, len(events))
+	for _, ev := range events {
+		err := tx.QueryRow(ctx, `
+			WITH author AS (
+				SELECT team_name FROM users WHERE user_id = $4
+			), owner AS (
+				SELECT r.team_name FROM pull_requests p
+				JOIN repositories r ON r.name = p.repository
+				WHERE p.pull_request_id = $3
+			)
+			INSERT INTO events (type, user_id, pull_request_id, author_id, team_name, review_team)
+			VALUES ($1, $2, $3, $4, (SELECT team_name FROM author),
+				COALESCE((SELECT team_name FROM owner), (SELECT team_name FROM author)))
+			RETURNING id, COALESCE(team_name, ''), COALESCE(review_team, ''), created_at`,
+			ev.Type, ev.UserID, ev.PullRequestID, ev.AuthorID).Scan(&ev.ID, &ev.TeamName, &ev.ReviewTeam, &ev.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("insert event: %w", err)
+		}
+
+		payload, err := json.Marshal(eventNotification{Origin: origin, Event: eventRecord(ev)})
+		if err != nil {
+			return nil, fmt.Errorf("encode event: %w", err)
+		}
+		if _, err := tx.Exec(ctx, `SELECT pg_notify($1, $2)`, EventsChannel, string(payload)); err != nil {
+			return nil, fmt.Errorf("notify event: %w", err)
+		}
+		stored = append(stored, ev)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit events: %w", err)
+	}
+	return stored, nil
+}
+
+func (r *eventRepo) List(ctx context.Context, f models.EventFilter) ([]models.Event, error) {
+	var args queryArgs
+	where := []string{"id > " + args.add(f.AfterID)}
+	if f.UserID != "" {
+		where = append(where, "user_id = "+args.add(f.UserID))
+	}
+	if f.TeamName != "" {
+		team := args.add(f.TeamName)
+		where = append(where, "(team_name = "+team+" OR review_team = "+team+")")
+	}
+
+	query := fmt.Sprintf(`
+		SELECT id, type, user_id, pull_request_id, author_id, COALESCE(team_name, ''), COALESCE(review_team, ''), created_at
+		FROM events
+		WHERE %s
+		ORDER BY id
+		LIMIT %s`, strings.Join(where, " AND "), args.add(f.Limit))
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []models.Event
+	for rows.Next() {
+		var ev models.Event
+		if err := rows.Scan(&ev.ID, &ev.Type, &ev.UserID, &ev.PullRequestID, &ev.AuthorID, &ev.TeamName, &ev.ReviewTeam, &ev.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan event: %w", err)
+		}
+		events = append(events, ev)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return events, nil
+}
+
+// Listen blocks on a dedicated connection and calls fn for every event
+// notification until ctx is done or the connection fails.
+func (r *eventRepo) Listen(ctx context.Context, fn func(origin string, ev models.Event)) error {
+	pooled, err := r.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("acquire conn: %w", err)
+	}
+	// The connection keeps its LISTEN state, so it must not go back to the pool.
+	conn := pooled.Hijack()
+	defer conn.Close(context.WithoutCancel(ctx))
+
+	if _, err := conn.Exec(ctx, "LISTEN "+EventsChannel); err != nil {
+		return fmt.Errorf("listen: %w", err)
+	}
+	for {
+		n, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return fmt.Errorf("wait for notification: %w", err)
+		}
+		var msg eventNotification
+		if err := json.Unmarshal([]byte(n.Payload), &msg); err != nil {
+			r.logger.Warn("malformed event notification", "err", err)
+			continue
+		}
+		fn(msg.Origin, models.Event(msg.Event))
+	}
+}
+
+func (r *eventRepo) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	tag, err := r.pool.Exec(ctx, `DELETE FROM events WHERE created_at < $1`, before.UTC())
+	if err != nil {
+		return 0, fmt.Errorf("delete old events: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
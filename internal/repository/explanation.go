@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+)
+
+type exclusionRow struct {
+	UserID string `json:"user_id"`
+	Reason string `json:"reason"`
+}
+
+// SaveExplanations stores why reviewers were picked for a pull request,
+// replacing earlier explanations of the same reviewers.
+func (r *repo) SaveExplanations(ctx context.Context, prID string, explanations []models.ReviewerExplanation) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	for _, e := range explanations {
+		excluded := make([]exclusionRow, 0, len(e.Excluded))
+		for _, x := range e.Excluded {
+			excluded = append(excluded, exclusionRow{UserID: x.UserID, Reason: x.Reason})
+		}
+		raw, err := json.Marshal(excluded)
+		if err != nil {
+			return fmt.Errorf("marshal exclusions: %w", err)
+		}
+		tags := e.MatchedTags
+		if tags == nil {
+			tags = []string{}
+		}
+		_, err = tx.Exec(ctx, `
+			INSERT INTO review_explanations
+				(pull_request_id, user_id, strategy, pool_size, excluded, matched_tags, in_working_hours, score, seed, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+			ON CONFLICT (pull_request_id, user_id) DO UPDATE
+			SET strategy = $3, pool_size = $4, excluded = $5, matched_tags = $6,
+				in_working_hours = $7, score = $8, seed = $9, created_at = $10`,
+			prID, e.ReviewerID, e.Strategy, e.PoolSize, raw, tags, e.InWorkingHours, e.Score, e.Seed, e.CreatedAt.UTC())
+		if err != nil {
+			return fmt.Errorf("insert explanation: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit: %w", err)
+	}
+	return nil
+}
+
+// GetExplanations returns the stored explanations keyed by reviewer id,
+// including those of reviewers no longer assigned.
+func (r *repo) GetExplanations(ctx context.Context, prID string) (map[string]models.ReviewerExplanation, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT user_id, strategy, pool_size, excluded, matched_tags, in_working_hours, score, seed, created_at
+		FROM review_explanations WHERE pull_request_id = $1`, prID)
+	if err != nil {
+		return nil, fmt.Errorf("query explanations: %w", err)
+	}
+	defer rows.Close()
+
+	out := make(map[string]models.ReviewerExplanation)
+	for rows.Next() {
+		var (
+			e   models.ReviewerExplanation
+			raw []byte
+		)
+		if err := rows.Scan(&e.ReviewerID, &e.Strategy, &e.PoolSize, &raw, &e.MatchedTags,
+			&e.InWorkingHours, &e.Score, &e.Seed, &e.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan explanation: %w", err)
+		}
+		var excluded []exclusionRow
+		if err := json.Unmarshal(raw, &excluded); err != nil {
+			return nil, fmt.Errorf("unmarshal exclusions: %w", err)
+		}
+		for _, x := range excluded {
+			e.Excluded = append(e.Excluded, models.Exclusion{UserID: x.UserID, Reason: x.Reason})
+		}
+		out[e.ReviewerID] = e
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return out, nil
+}
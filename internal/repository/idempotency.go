@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+)
+
+type idempotencyRepo struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+func NewIdempotencyRepository(pool *pgxpool.Pool, logger *slog.Logger) IdempotencyRepository {
+	return &idempotencyRepo{pool: pool, logger: logger}
+}
+
+// Reserve stores a pending record for the key. If a live record already
+// exists it is returned with reserved = false; an expired one is replaced.
+func (r *idempotencyRepo) Reserve(ctx context.Context, rec models.IdempotencyRecord) (*models.IdempotencyRecord, bool, error) {
+	tag, err := r.pool.Exec(ctx, `
+		INSERT INTO idempotency_keys (client, idempotency_key, route, request_hash, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (client, idempotency_key, route) DO UPDATE
+		SET request_hash = EXCLUDED.request_hash, status_code = NULL, response_body = NULL,
+		    created_at = NOW(), expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at < NOW()`,
+		rec.Client, rec.Key, rec.Route, rec.RequestHash, rec.ExpiresAt.UTC())
+	if err != nil {
+		return nil, false, fmt.Errorf("reserve idempotency key: %w", err)
+	}
+	if tag.RowsAffected() == 1 {
+		return &rec, true, nil
+	}
+
+	existing := &models.IdempotencyRecord{}
+	var status *int
+	err = r.pool.QueryRow(ctx, `
+		SELECT client, idempotency_key, route, request_hash, status_code, response_body, expires_at
+		FROM idempotency_keys WHERE client = $1 AND idempotency_key = $2 AND route = $3`,
+		rec.Client, rec.Key, rec.Route).
+		Scan(&existing.Client, &existing.Key, &existing.Route, &existing.RequestHash, &status, &existing.Body, &existing.ExpiresAt)
+	if err != nil {
+		return nil, false, fmt.Errorf("get idempotency key: %w", err)
+	}
+	if status != nil {
+		existing.StatusCode = *status
+	}
+	return existing, false, nil
+}
+
+func (r *idempotencyRepo) Complete(ctx context.Context, rec models.IdempotencyRecord, statusCode int, body []byte) error {
+	_, err := r.pool.Exec(ctx, `
+		UPDATE idempotency_keys SET status_code = $4, response_body = $5
+		WHERE client = $1 AND idempotency_key = $2 AND route = $3`,
+		rec.Client, rec.Key, rec.Route, statusCode, body)
+	if err != nil {
+		return fmt.Errorf("complete idempotency key: %w", err)
+	}
+	return nil
+}
+
+// Release drops a pending record so the request can be retried.
+func (r *idempotencyRepo) Release(ctx context.Context, rec models.IdempotencyRecord) error {
+	_, err := r.pool.Exec(ctx, `
+		DELETE FROM idempotency_keys
+		WHERE client = $1 AND idempotency_key = $2 AND route = $3 AND status_code IS NULL`,
+		rec.Client, rec.Key, rec.Route)
+	if err != nil {
+		return fmt.Errorf("release idempotency key: %w", err)
+	}
+	return nil
+}
+
+func (r *idempotencyRepo) DeleteExpired(ctx context.Context) (int64, error) {
+	tag, err := r.pool.Exec(ctx, `DELETE FROM idempotency_keys WHERE expires_at < NOW()`)
+	if err != nil {
+		return 0, fmt.Errorf("delete expired idempotency keys: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
@@ -2,21 +2,83 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
 )
 
 type PRRepository interface {
-	CreateOrUpdateTeam(ctx context.Context, team models.Team) error
+	InTx(ctx context.Context, fn func(PRRepository) error) error
+
+	CreateOrUpdateTeam(ctx context.Context, team models.Team, policy models.MovePolicy) error
 	GetTeam(ctx context.Context, teamName string) (*models.Team, error)
+	ListTeams(ctx context.Context, names []string) ([]models.Team, error)
+	LockRoster(ctx context.Context, teams, userIDs []string) error
+	ImportTeams(ctx context.Context, teams []models.Team, deactivate []string) error
+	DetachUsers(ctx context.Context, userIDs []string) error
+	DeleteTeam(ctx context.Context, teamName string) error
 	SetUserActive(ctx context.Context, userID string, isActive bool) (*models.User, error)
 	GetUserTeam(ctx context.Context, userID string) (string, error)
-	RandomActiveMemberFromTeam(ctx context.Context, teamName, excludeID string) (string, error)
+	GetUsers(ctx context.Context, userIDs []string) ([]models.User, error)
+	GetUserDetails(ctx context.Context, userID string) (*models.UserDetails, error)
+	ListUsers(ctx context.Context, filter models.UserFilter) ([]models.User, error)
+	MoveUser(ctx context.Context, userID, teamName string, policy models.MovePolicy) (*models.User, error)
 
-	GetUserReviewPRs(ctx context.Context, userID string) ([]models.PRShort, error)
+	GetUserReviewPRs(ctx context.Context, userID string, filter models.ReviewFilter) ([]models.PRShort, error)
 	CreatePR(ctx context.Context, pr models.PullRequest) error
 	GetPR(ctx context.Context, prID string) (*models.PullRequest, error)
+	ListPRs(ctx context.Context, filter models.PRFilter) ([]models.PullRequest, error)
 	MergePR(ctx context.Context, prID string) (*models.PullRequest, error)
+	MarkPRReady(ctx context.Context, prID string, reviewers []string) (*models.PullRequest, error)
 	ReassignReviewer(ctx context.Context, prID, oldUserID, newUserID string) (*models.PullRequest, error)
+	RemoveReviewer(ctx context.Context, prID, userID string) (*models.PullRequest, error)
+	GetOpenPRsByUsers(ctx context.Context, userIDs []string) ([]models.PullRequest, error)
 	GetActiveMembersExcluding(ctx context.Context, teamName string, excludeID string) ([]string, error)
+
+	SetTeamCodeowners(ctx context.Context, teamName, content string) error
+	GetTeamCodeowners(ctx context.Context, teamName string) (string, error)
+
+	SetUserTags(ctx context.Context, userID string, tags []string) ([]string, error)
+	GetUsersTags(ctx context.Context, userIDs []string) (map[string][]string, error)
+
+	SaveExplanations(ctx context.Context, prID string, explanations []models.ReviewerExplanation) error
+	GetExplanations(ctx context.Context, prID string) (map[string]models.ReviewerExplanation, error)
+
+	SetUserSchedule(ctx context.Context, userID string, schedule *models.WorkSchedule) error
+	GetUserSchedules(ctx context.Context, userIDs []string) (map[string]*models.WorkSchedule, error)
+
+	GetMemberLoads(ctx context.Context, filter models.FairnessFilter) ([]models.MemberLoad, error)
+
+	SetPairRules(ctx context.Context, teamName string, rules []models.PairRule) error
+	GetPairRules(ctx context.Context, teamName string) ([]models.PairRule, error)
+	GetAuthorPairRules(ctx context.Context, teamName, authorID string) ([]models.PairRule, error)
+
+	SetUserSeniority(ctx context.Context, userID, seniority string) error
+	GetUsersSeniority(ctx context.Context, userIDs []string) (map[string]string, error)
+	SetTeamMentorship(ctx context.Context, teamName string, enabled bool) error
+	SetTeamLargePRLines(ctx context.Context, teamName string, lines int) error
+
+	SetRepository(ctx context.Context, repository models.Repository) error
+	GetRepository(ctx context.Context, name string) (*models.Repository, error)
+	ListRepositories(ctx context.Context) ([]models.Repository, error)
+
+	SetTeamSLA(ctx context.Context, sla models.TeamSLA) error
+	GetTeamSLA(ctx context.Context, teamName string) (*models.TeamSLA, error)
+	GetOpenAssignments(ctx context.Context, filter models.OverdueFilter) ([]models.ReviewAssignment, error)
+	MarkReminded(ctx context.Context, ids []int64, at time.Time) error
+	MarkEscalated(ctx context.Context, ids []int64, at time.Time) error
+}
+
+type IdempotencyRepository interface {
+	Reserve(ctx context.Context, rec models.IdempotencyRecord) (*models.IdempotencyRecord, bool, error)
+	Complete(ctx context.Context, rec models.IdempotencyRecord, statusCode int, body []byte) error
+	Release(ctx context.Context, rec models.IdempotencyRecord) error
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
+type EventRepository interface {
+	Append(ctx context.Context, origin string, events []models.Event) ([]models.Event, error)
+	List(ctx context.Context, filter models.EventFilter) ([]models.Event, error)
+	Listen(ctx context.Context, fn func(origin string, ev models.Event)) error
+	DeleteBefore(ctx context.Context, before time.Time) (int64, error)
 }
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Locker hands out Postgres session advisory locks, used to make sure a
+// background job runs on one replica at a time.
+type Locker interface {
+	// TryLock returns ok = false without waiting when another session holds
+	// the lock. The returned unlock must be called once the work is done.
+	TryLock(ctx context.Context, key int64) (unlock func(), ok bool, err error)
+}
+
+type pgLocker struct {
+	pool *pgxpool.Pool
+}
+
+func NewLocker(pool *pgxpool.Pool) Locker {
+	return &pgLocker{pool: pool}
+}
+
+func (l *pgLocker) TryLock(ctx context.Context, key int64) (func(), bool, error) {
+	conn, err := l.pool.Acquire(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("acquire conn: %w", err)
+	}
+	var ok bool
+	if err := conn.QueryRow(ctx, `SELECT pg_try_advisory_lock($1)`, key).Scan(&ok); err != nil {
+		conn.Release()
+		return nil, false, fmt.Errorf("try advisory lock: %w", err)
+	}
+	if !ok {
+		conn.Release()
+		return nil, false, nil
+	}
+	unlock := func() {
+		// The lock belongs to the session, so it is released on the same
+		// connection; if that fails the connection is closed to drop it.
+		if _, err := conn.Exec(context.Background(), `SELECT pg_advisory_unlock($1)`, key); err != nil {
+			conn.Hijack().Close(context.Background())
+			return
+		}
+		conn.Release()
+	}
+	return unlock, true, nil
+}
@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+)
+
+func (r *repo) SetUserSeniority(ctx context.Context, userID, seniority string) error {
+	tag, err := r.db.Exec(ctx, `UPDATE users SET seniority = $2 WHERE user_id = $1`, userID, seniority)
+	if err != nil {
+		return fmt.Errorf("set seniority: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrUserNotFound
+	}
+	return nil
+}
+
+// GetUsersSeniority returns seniority keyed by user id; unknown users are
+// omitted.
+func (r *repo) GetUsersSeniority(ctx context.Context, userIDs []string) (map[string]string, error) {
+	rows, err := r.db.Query(ctx, `SELECT user_id, seniority FROM users WHERE user_id = ANY($1)`, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("query seniority: %w", err)
+	}
+	defer rows.Close()
+
+	out := make(map[string]string)
+	for rows.Next() {
+		var id, level string
+		if err := rows.Scan(&id, &level); err != nil {
+			return nil, fmt.Errorf("scan seniority: %w", err)
+		}
+		out[id] = level
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return out, nil
+}
+
+func (r *repo) SetTeamMentorship(ctx context.Context, teamName string, enabled bool) error {
+	tag, err := r.db.Exec(ctx, `UPDATE teams SET mentorship = $2 WHERE team_name = $1`, teamName, enabled)
+	if err != nil {
+		return fmt.Errorf("set mentorship: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrTeamNotFound
+	}
+	return nil
+}
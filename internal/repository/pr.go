@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+)
+
+func (r *repo) ListPRs(ctx context.Context, f models.PRFilter) ([]models.PullRequest, error) {
+	var args queryArgs
+	where := []string{"TRUE"}
+	if f.TeamName != "" {
+		// A team sees the pull requests its members author or review and those
+		// in repositories it owns.
+		team := args.add(f.TeamName)
+		where = append(where, fmt.Sprintf(`(author_id IN (SELECT user_id FROM users WHERE team_name = %[1]s)
+			OR repository IN (SELECT name FROM repositories WHERE team_name = %[1]s)
+			OR pull_request_id IN (SELECT rv.pull_request_id FROM pr_reviewers rv
+				JOIN users u ON u.user_id = rv.user_id WHERE u.team_name = %[1]s))`, team))
+	}
+	if f.AuthorID != "" {
+		where = append(where, "author_id = "+args.add(f.AuthorID))
+	}
+	if f.Repository != "" {
+		where = append(where, "repository = "+args.add(f.Repository))
+	}
+	if f.ReviewerID != "" {
+		where = append(where, "assigned_reviewers @> ARRAY["+args.add(f.ReviewerID)+"]::text[]")
+	}
+	if f.Status != "" {
+		where = append(where, "status = "+args.add(f.Status))
+	}
+	if f.NameContains != "" {
+		where = append(where, "pull_request_name ILIKE "+args.add("%"+escapeLike(f.NameContains)+"%"))
+	}
+	if f.CreatedAfter != nil {
+		where = append(where, "created_at >= "+args.add(f.CreatedAfter.UTC()))
+	}
+	if f.CreatedBefore != nil {
+		where = append(where, "created_at < "+args.add(f.CreatedBefore.UTC()))
+	}
+	order := "DESC"
+	if f.Ascending {
+		order = "ASC"
+	}
+	if f.After != nil {
+		cmp := "<"
+		if f.Ascending {
+			cmp = ">"
+		}
+		where = append(where, fmt.Sprintf("(created_at, pull_request_id) %s (%s, %s)",
+			cmp, args.add(f.After.CreatedAt.UTC()), args.add(f.After.ID)))
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM pull_requests
+		WHERE %s
+		ORDER BY created_at %s, pull_request_id %s
+		LIMIT %s`, prColumns, strings.Join(where, " AND "), order, order, args.add(f.Limit))
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query prs: %w", err)
+	}
+	defer rows.Close()
+
+	return collectPRs(rows)
+}
+
+// prColumns is the full pull_requests column list read by scanPR.
+const prColumns = `pull_request_id, pull_request_name, author_id, status, assigned_reviewers, is_draft,
+	changed_files, required_tags, repository, url, labels, lines_added, lines_removed, description,
+	created_at, merged_at`
+
+func scanPR(row pgx.Row) (*models.PullRequest, error) {
+	pr := &models.PullRequest{}
+	err := row.Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status, &pr.AssignedReviewers, &pr.IsDraft,
+		&pr.ChangedFiles, &pr.RequiredTags, &pr.Repository, &pr.URL, &pr.Labels, &pr.LinesAdded, &pr.LinesRemoved,
+		&pr.Description, &pr.CreatedAt, &pr.MergedAt)
+	if err != nil {
+		return nil, err
+	}
+	return pr, nil
+}
+
+// collectPRs scans rows selected with prColumns.
+func collectPRs(rows pgx.Rows) ([]models.PullRequest, error) {
+	var prs []models.PullRequest
+	for rows.Next() {
+		pr, err := scanPR(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan pr: %w", err)
+		}
+		prs = append(prs, *pr)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return prs, nil
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
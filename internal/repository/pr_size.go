@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+)
+
+// SetTeamLargePRLines sets the changed-line count from which pull requests
+// of the team get an extra reviewer; zero turns this off.
+func (r *repo) SetTeamLargePRLines(ctx context.Context, teamName string, lines int) error {
+	tag, err := r.db.Exec(ctx, `UPDATE teams SET large_pr_lines = NULLIF($2, 0) WHERE team_name = $1`, teamName, lines)
+	if err != nil {
+		return fmt.Errorf("set large pr lines: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrTeamNotFound
+	}
+	return nil
+}
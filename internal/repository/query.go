@@ -0,0 +1,12 @@
+package repository
+
+import "strconv"
+
+// queryArgs collects positional arguments for dynamically built queries.
+type queryArgs []any
+
+// add appends v and returns its placeholder.
+func (a *queryArgs) add(v any) string {
+	*a = append(*a, v)
+	return "$" + strconv.Itoa(len(*a))
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+)
+
+// SetRepository creates a repository or changes its team. It returns
+// ErrTeamNotFound if the team does not exist.
+func (r *repo) SetRepository(ctx context.Context, repository models.Repository) error {
+	tag, err := r.db.Exec(ctx, `
+		INSERT INTO repositories (name, team_name)
+		SELECT $1, NULLIF($2, '')
+		WHERE $2 = '' OR EXISTS (SELECT 1 FROM teams WHERE team_name = $2)
+		ON CONFLICT (name) DO UPDATE SET team_name = EXCLUDED.team_name`,
+		repository.Name, repository.TeamName)
+	if err != nil {
+		return fmt.Errorf("set repository: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrTeamNotFound
+	}
+	return nil
+}
+
+func (r *repo) GetRepository(ctx context.Context, name string) (*models.Repository, error) {
+	repository := &models.Repository{Name: name}
+	err := r.db.QueryRow(ctx, `SELECT COALESCE(team_name, '') FROM repositories WHERE name = $1`, name).
+		Scan(&repository.TeamName)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrRepositoryNotFound
+		}
+		return nil, fmt.Errorf("get repository: %w", err)
+	}
+	return repository, nil
+}
+
+func (r *repo) ListRepositories(ctx context.Context) ([]models.Repository, error) {
+	rows, err := r.db.Query(ctx, `SELECT name, COALESCE(team_name, '') FROM repositories ORDER BY name`)
+	if err != nil {
+		return nil, fmt.Errorf("query repositories: %w", err)
+	}
+	defer rows.Close()
+
+	var out []models.Repository
+	for rows.Next() {
+		var repository models.Repository
+		if err := rows.Scan(&repository.Name, &repository.TeamName); err != nil {
+			return nil, fmt.Errorf("scan repository: %w", err)
+		}
+		out = append(out, repository)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return out, nil
+}
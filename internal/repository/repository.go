@@ -5,42 +5,98 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
-	"time"
+	"strings"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
 )
 
 var (
-	ErrTeamNotFound = errors.New("team not found")
-	ErrUserNotFound = errors.New("user not found")
-	ErrPRNotFound   = errors.New("pr not found")
+	ErrTeamNotFound       = errors.New("team not found")
+	ErrUserNotFound       = errors.New("user not found")
+	ErrUserNoTeam         = errors.New("user has no team")
+	ErrPRNotFound         = errors.New("pr not found")
+	ErrNoCandidate        = errors.New("no candidate")
+	ErrPRNotDraft         = errors.New("pr is not a draft")
+	ErrRepositoryNotFound = errors.New("repository not found")
+	ErrPRMerged           = errors.New("PR_MERGED")
+	ErrNotAssigned        = errors.New("NOT_ASSIGNED")
 )
 
+// querier is implemented by both the pool and a transaction, so every
+// repository method can run either standalone or inside InTx. Begin on a
+// transaction opens a savepoint.
+type querier interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
 type repo struct {
-	pool   *pgxpool.Pool
+	db     querier
 	logger *slog.Logger
 }
 
 func NewRepository(pool *pgxpool.Pool, logger *slog.Logger) PRRepository {
-	return &repo{pool: pool, logger: logger}
+	return &repo{db: pool, logger: logger}
+}
+
+// InTx runs fn with a repository bound to a single transaction, committing
+// when fn returns nil and rolling back otherwise. Nested calls share the
+// outer transaction through a savepoint.
+func (r *repo) InTx(ctx context.Context, fn func(PRRepository) error) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := fn(&repo{db: tx, logger: r.logger}); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+	return nil
 }
 
-func (r *repo) CreateOrUpdateTeam(ctx context.Context, team models.Team) error {
-	tx, err := r.pool.Begin(ctx)
+// CreateOrUpdateTeam upserts the team and its members. Members coming from
+// another team are recorded as moved with the given review policy.
+func (r *repo) CreateOrUpdateTeam(ctx context.Context, team models.Team, policy models.MovePolicy) error {
+	tx, err := r.db.Begin(ctx)
 	if err != nil {
 		return fmt.Errorf("begin tx: %w", err)
 	}
 	defer tx.Rollback(ctx)
 
-	_, err = tx.Exec(ctx, `INSERT INTO teams (team_name) VALUES ($1) ON CONFLICT DO NOTHING`, team.Name)
+	if err := upsertTeam(ctx, tx, team, policy); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// upsertTeam creates the team if needed and upserts its members, recording
+// team changes in the history with the policy applied to their reviews.
+func upsertTeam(ctx context.Context, tx pgx.Tx, team models.Team, policy models.MovePolicy) error {
+	_, err := tx.Exec(ctx, `INSERT INTO teams (team_name) VALUES ($1) ON CONFLICT DO NOTHING`, team.Name)
 	if err != nil {
 		return fmt.Errorf("insert team: %w", err)
 	}
 
 	for _, m := range team.Members {
+		_, err = tx.Exec(ctx, `
+			INSERT INTO user_team_history (user_id, from_team, to_team, review_policy)
+			SELECT user_id, team_name, $2, $3 FROM users
+			WHERE user_id = $1 AND team_name IS DISTINCT FROM $2`,
+			m.ID, team.Name, string(policy))
+		if err != nil {
+			return fmt.Errorf("record team move: %w", err)
+		}
+
 		_, err = tx.Exec(ctx,
 			`INSERT INTO users (user_id, username, team_name, is_active)
 			 VALUES ($1, $2, $3, $4)
@@ -50,25 +106,22 @@ func (r *repo) CreateOrUpdateTeam(ctx context.Context, team models.Team) error {
 			return fmt.Errorf("upsert user: %w", err)
 		}
 	}
-
-	return tx.Commit(ctx)
+	return nil
 }
 
 func (r *repo) GetTeam(ctx context.Context, teamName string) (*models.Team, error) {
-	var exists bool
-	err := r.pool.QueryRow(ctx,
-		`SELECT EXISTS(SELECT 1 FROM teams WHERE team_name = $1)`, teamName,
-	).Scan(&exists)
+	team := &models.Team{Name: teamName}
+	err := r.db.QueryRow(ctx,
+		`SELECT mentorship, COALESCE(large_pr_lines, 0) FROM teams WHERE team_name = $1`, teamName,
+	).Scan(&team.Mentorship, &team.LargePRLines)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrTeamNotFound
+		}
 		return nil, fmt.Errorf("check team exists in teams table: %w", err)
 	}
-	if !exists {
-		return nil, ErrTeamNotFound
-	}
-
-	team := &models.Team{Name: teamName}
 
-	rows, err := r.pool.Query(ctx,
+	rows, err := r.db.Query(ctx,
 		`SELECT user_id, username, is_active FROM users WHERE team_name = $1 ORDER BY user_id`, teamName)
 	if err != nil {
 		return nil, fmt.Errorf("query team members: %w", err)
@@ -91,13 +144,13 @@ func (r *repo) GetTeam(ctx context.Context, teamName string) (*models.Team, erro
 
 func (r *repo) SetUserActive(ctx context.Context, userID string, isActive bool) (*models.User, error) {
 	var u models.User
-	err := r.pool.QueryRow(ctx, `
+	err := r.db.QueryRow(ctx, `
 		UPDATE users SET is_active = $2 WHERE user_id = $1
-		RETURNING user_id, username, team_name, is_active`,
+		RETURNING user_id, username, COALESCE(team_name, ''), is_active`,
 		userID, isActive).Scan(&u.ID, &u.Username, &u.TeamName, &u.IsActive)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, errors.New("user not found")
+			return nil, ErrUserNotFound
 		}
 		return nil, fmt.Errorf("set active: %w", err)
 	}
@@ -105,36 +158,27 @@ func (r *repo) SetUserActive(ctx context.Context, userID string, isActive bool)
 }
 
 func (r *repo) GetUserTeam(ctx context.Context, userID string) (string, error) {
-	var teamName string
-	err := r.pool.QueryRow(ctx, `SELECT team_name FROM users WHERE user_id = $1`, userID).Scan(&teamName)
+	var teamName *string
+	err := r.db.QueryRow(ctx, `SELECT team_name FROM users WHERE user_id = $1`, userID).Scan(&teamName)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return "", errors.New("user not found")
+			return "", ErrUserNotFound
 		}
 		return "", fmt.Errorf("get user team: %w", err)
 	}
-	return teamName, nil
-}
-
-func (r *repo) RandomActiveMemberFromTeam(ctx context.Context, teamName, excludeID string) (string, error) {
-	var userID string
-	err := r.pool.QueryRow(ctx, `
-		SELECT user_id FROM users
-		WHERE team_name = $1 AND is_active = true AND user_id != $2
-		ORDER BY RANDOM() LIMIT 1`, teamName, excludeID).Scan(&userID)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return "", errors.New("no candidate")
-		}
-		return "", fmt.Errorf("random member: %w", err)
+	if teamName == nil {
+		return "", ErrUserNoTeam
 	}
-	return userID, nil
+	return *teamName, nil
 }
 
+// GetActiveMembersExcluding returns active team members other than excludeID
+// ordered by id, so that a seeded pick over them can be replayed.
 func (r *repo) GetActiveMembersExcluding(ctx context.Context, teamName, excludeID string) ([]string, error) {
-	rows, err := r.pool.Query(ctx, `
-		SELECT user_id FROM users 
-		WHERE team_name = $1 AND is_active = true AND user_id != $2`, teamName, excludeID)
+	rows, err := r.db.Query(ctx, `
+		SELECT user_id FROM users
+		WHERE team_name = $1 AND is_active = true AND user_id != $2
+		ORDER BY user_id`, teamName, excludeID)
 	if err != nil {
 		return nil, fmt.Errorf("query active members: %w", err)
 	}
@@ -154,11 +198,49 @@ func (r *repo) GetActiveMembersExcluding(ctx context.Context, teamName, excludeI
 	return members, nil
 }
 
-func (r *repo) GetUserReviewPRs(ctx context.Context, userID string) ([]models.PRShort, error) {
-	rows, err := r.pool.Query(ctx, `
-		SELECT p.pull_request_id, p.pull_request_name, p.author_id, p.status
-		FROM pull_requests p, unnest(p.assigned_reviewers) AS r
-		WHERE r = $1`, userID)
+func (r *repo) GetUserReviewPRs(ctx context.Context, userID string, f models.ReviewFilter) ([]models.PRShort, error) {
+	var args queryArgs
+	where := []string{"rv.user_id = " + args.add(userID), "NOT p.is_draft"}
+	if f.Status != "" {
+		where = append(where, "rv.status = "+args.add(f.Status))
+	}
+	if f.AuthorID != "" {
+		where = append(where, "rv.author_id = "+args.add(f.AuthorID))
+	}
+	if f.Repository != "" {
+		where = append(where, "p.repository = "+args.add(f.Repository))
+	}
+	if f.Label != "" {
+		where = append(where, "p.labels @> ARRAY["+args.add(f.Label)+"]::text[]")
+	}
+	if f.CreatedAfter != nil {
+		where = append(where, "rv.created_at >= "+args.add(f.CreatedAfter.UTC()))
+	}
+	if f.CreatedBefore != nil {
+		where = append(where, "rv.created_at < "+args.add(f.CreatedBefore.UTC()))
+	}
+	order := "DESC"
+	if f.Ascending {
+		order = "ASC"
+	}
+	if f.After != nil {
+		cmp := "<"
+		if f.Ascending {
+			cmp = ">"
+		}
+		where = append(where, fmt.Sprintf("(rv.created_at, rv.pull_request_id) %s (%s, %s)",
+			cmp, args.add(f.After.CreatedAt.UTC()), args.add(f.After.ID)))
+	}
+
+	query := fmt.Sprintf(`
+		SELECT p.pull_request_id, p.pull_request_name, p.author_id, p.status, p.repository, p.url, p.labels, p.created_at
+		FROM pr_reviewers rv
+		JOIN pull_requests p ON p.pull_request_id = rv.pull_request_id
+		WHERE %s
+		ORDER BY rv.created_at %s, rv.pull_request_id %s
+		LIMIT %s`, strings.Join(where, " AND "), order, order, args.add(f.Limit))
+
+	rows, err := r.db.Query(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("query reviews: %w", err)
 	}
@@ -167,7 +249,7 @@ func (r *repo) GetUserReviewPRs(ctx context.Context, userID string) ([]models.PR
 	var prs []models.PRShort
 	for rows.Next() {
 		var pr models.PRShort
-		if err := rows.Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status); err != nil {
+		if err := rows.Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status, &pr.Repository, &pr.URL, &pr.Labels, &pr.CreatedAt); err != nil {
 			return nil, fmt.Errorf("scan pr: %w", err)
 		}
 		prs = append(prs, pr)
@@ -179,10 +261,14 @@ func (r *repo) GetUserReviewPRs(ctx context.Context, userID string) ([]models.PR
 }
 
 func (r *repo) CreatePR(ctx context.Context, pr models.PullRequest) error {
-	_, err := r.pool.Exec(ctx, `
-		INSERT INTO pull_requests (pull_request_id, pull_request_name, author_id, status, assigned_reviewers, created_at)
-		VALUES ($1, $2, $3, 'OPEN', $4, NOW())`,
-		pr.ID, pr.Name, pr.AuthorID, pr.AssignedReviewers)
+	_, err := r.db.Exec(ctx, `
+		INSERT INTO pull_requests (pull_request_id, pull_request_name, author_id, status, assigned_reviewers,
+			is_draft, changed_files, required_tags, repository, url, labels, lines_added, lines_removed, description,
+			created_at)
+		VALUES ($1, $2, $3, 'OPEN', COALESCE($4::text[], '{}'), $5, COALESCE($6::text[], '{}'), COALESCE($7::text[], '{}'),
+			$8, $9, COALESCE($10::text[], '{}'), $11, $12, $13, NOW())`,
+		pr.ID, pr.Name, pr.AuthorID, pr.AssignedReviewers, pr.IsDraft, pr.ChangedFiles, pr.RequiredTags,
+		pr.Repository, pr.URL, pr.Labels, pr.LinesAdded, pr.LinesRemoved, pr.Description)
 	if err != nil {
 		return fmt.Errorf("create pr: %w", err)
 	}
@@ -190,67 +276,73 @@ func (r *repo) CreatePR(ctx context.Context, pr models.PullRequest) error {
 }
 
 func (r *repo) GetPR(ctx context.Context, prID string) (*models.PullRequest, error) {
-	pr := &models.PullRequest{}
-	var createdAt, mergedAt *time.Time
-
-	err := r.pool.QueryRow(ctx, `
-		SELECT pull_request_id, pull_request_name, author_id, status, assigned_reviewers, created_at, merged_at
-		FROM pull_requests WHERE pull_request_id = $1`, prID).
-		Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status, &pr.AssignedReviewers, &createdAt, &mergedAt)
+	pr, err := scanPR(r.db.QueryRow(ctx, `
+		SELECT `+prColumns+`
+		FROM pull_requests WHERE pull_request_id = $1`, prID))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, errors.New("pr not found")
+			return nil, ErrPRNotFound
 		}
 		return nil, fmt.Errorf("get pr: %w", err)
 	}
-
-	pr.CreatedAt = createdAt
-	pr.MergedAt = mergedAt
 	return pr, nil
 }
 
 func (r *repo) MergePR(ctx context.Context, prID string) (*models.PullRequest, error) {
-	pr := &models.PullRequest{}
-	var createdAt, mergedAt *time.Time
-
-	err := r.pool.QueryRow(ctx, `
+	pr, err := scanPR(r.db.QueryRow(ctx, `
 		UPDATE pull_requests SET status = 'MERGED', merged_at = COALESCE(merged_at, NOW())
 		WHERE pull_request_id = $1 AND status = 'OPEN'
-		RETURNING pull_request_id, pull_request_name, author_id, status, assigned_reviewers, created_at, merged_at`, prID).
-		Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status, &pr.AssignedReviewers, &createdAt, &mergedAt)
-
+		RETURNING `+prColumns, prID))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return r.GetPR(ctx, prID)
 		}
 		return nil, fmt.Errorf("merge pr: %w", err)
 	}
+	return pr, nil
+}
 
-	pr.CreatedAt = createdAt
-	pr.MergedAt = mergedAt
+// MarkPRReady takes an open pull request out of draft with the given
+// reviewers. It returns ErrPRNotDraft if the pull request is not an open draft.
+func (r *repo) MarkPRReady(ctx context.Context, prID string, reviewers []string) (*models.PullRequest, error) {
+	pr, err := scanPR(r.db.QueryRow(ctx, `
+		UPDATE pull_requests SET is_draft = false, assigned_reviewers = COALESCE($2::text[], '{}')
+		WHERE pull_request_id = $1 AND status = 'OPEN' AND is_draft
+		RETURNING `+prColumns, prID, reviewers))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrPRNotDraft
+		}
+		return nil, fmt.Errorf("mark pr ready: %w", err)
+	}
 	return pr, nil
 }
 
-func (r *repo) ReassignReviewer(ctx context.Context, prID, oldUserID, newUserID string) (*models.PullRequest, error) {
-	pr := &models.PullRequest{}
-	var createdAt, mergedAt *time.Time
+// reviewerConflict explains why a reviewer change matched no open pull
+// request: it is missing, merged, or the reviewer was already replaced.
+func (r *repo) reviewerConflict(ctx context.Context, prID string) error {
+	pr, err := r.GetPR(ctx, prID)
+	switch {
+	case err != nil:
+		return err
+	case pr.Status == "MERGED":
+		return ErrPRMerged
+	default:
+		return ErrNotAssigned
+	}
+}
 
-	err := r.pool.QueryRow(ctx, `
-		UPDATE pull_requests 
+func (r *repo) ReassignReviewer(ctx context.Context, prID, oldUserID, newUserID string) (*models.PullRequest, error) {
+	pr, err := scanPR(r.db.QueryRow(ctx, `
+		UPDATE pull_requests
 		SET assigned_reviewers = array_replace(assigned_reviewers, $2, $3)
 		WHERE pull_request_id = $1 AND status = 'OPEN' AND $2 = ANY(assigned_reviewers)
-		RETURNING pull_request_id, pull_request_name, author_id, status, assigned_reviewers, created_at, merged_at`,
-		prID, oldUserID, newUserID).
-		Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status, &pr.AssignedReviewers, &createdAt, &mergedAt)
-
+		RETURNING `+prColumns, prID, oldUserID, newUserID))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, errors.New("cannot reassign")
+			return nil, r.reviewerConflict(ctx, prID)
 		}
 		return nil, fmt.Errorf("reassign: %w", err)
 	}
-
-	pr.CreatedAt = createdAt
-	pr.MergedAt = mergedAt
 	return pr, nil
 }
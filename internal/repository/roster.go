@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+)
+
+// ListTeams returns the named teams with their members, or every team when
+// names is empty. Teams are ordered by name and members by user id.
+func (r *repo) ListTeams(ctx context.Context, names []string) ([]models.Team, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT t.team_name, u.user_id, u.username, u.is_active
+		FROM teams t
+		LEFT JOIN users u ON u.team_name = t.team_name
+		WHERE COALESCE(cardinality($1::text[]), 0) = 0 OR t.team_name = ANY($1)
+		ORDER BY t.team_name, u.user_id`, names)
+	if err != nil {
+		return nil, fmt.Errorf("query teams: %w", err)
+	}
+	defer rows.Close()
+
+	var teams []models.Team
+	for rows.Next() {
+		var (
+			name     string
+			id, user *string
+			active   *bool
+		)
+		if err := rows.Scan(&name, &id, &user, &active); err != nil {
+			return nil, fmt.Errorf("scan team member: %w", err)
+		}
+		if len(teams) == 0 || teams[len(teams)-1].Name != name {
+			teams = append(teams, models.Team{Name: name})
+		}
+		if id != nil {
+			t := &teams[len(teams)-1]
+			t.Members = append(t.Members, models.Member{ID: *id, Username: *user, IsActive: *active})
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return teams, nil
+}
+
+func (r *repo) GetUsers(ctx context.Context, userIDs []string) ([]models.User, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT user_id, username, COALESCE(team_name, ''), is_active
+		FROM users WHERE user_id = ANY($1)`, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("query users: %w", err)
+	}
+	defer rows.Close()
+
+	var users []models.User
+	for rows.Next() {
+		var u models.User
+		if err := rows.Scan(&u.ID, &u.Username, &u.TeamName, &u.IsActive); err != nil {
+			return nil, fmt.Errorf("scan user: %w", err)
+		}
+		users = append(users, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return users, nil
+}
+
+// LockRoster locks the named teams, their members and the given users until
+// the surrounding transaction ends, so an import diff stays valid while it is
+// applied. Rows are locked in key order to avoid deadlocks between imports.
+func (r *repo) LockRoster(ctx context.Context, teams, userIDs []string) error {
+	_, err := r.db.Exec(ctx, `
+		SELECT 1 FROM teams WHERE team_name = ANY($1) ORDER BY team_name FOR UPDATE`, teams)
+	if err != nil {
+		return fmt.Errorf("lock teams: %w", err)
+	}
+	_, err = r.db.Exec(ctx, `
+		SELECT 1 FROM users WHERE user_id = ANY($2) OR team_name = ANY($1)
+		ORDER BY user_id FOR UPDATE`, teams, userIDs)
+	if err != nil {
+		return fmt.Errorf("lock users: %w", err)
+	}
+	return nil
+}
+
+// ImportTeams upserts every team and deactivates the given users in a single
+// transaction. Moves are recorded with the reassign policy: the caller hands
+// the open reviews of moved and deactivated users over in the same
+// transaction.
+func (r *repo) ImportTeams(ctx context.Context, teams []models.Team, deactivate []string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	for _, team := range teams {
+		if err := upsertTeam(ctx, tx, team, models.MovePolicyReassign); err != nil {
+			return fmt.Errorf("team %s: %w", team.Name, err)
+		}
+	}
+	if len(deactivate) > 0 {
+		_, err = tx.Exec(ctx, `UPDATE users SET is_active = false WHERE user_id = ANY($1)`, deactivate)
+		if err != nil {
+			return fmt.Errorf("deactivate users: %w", err)
+		}
+	}
+	return tx.Commit(ctx)
+}
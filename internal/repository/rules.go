@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+)
+
+// SetPairRules replaces the pair rules of a team.
+func (r *repo) SetPairRules(ctx context.Context, teamName string, rules []models.PairRule) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var exists bool
+	err = tx.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM teams WHERE team_name = $1)`, teamName).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("check team exists: %w", err)
+	}
+	if !exists {
+		return ErrTeamNotFound
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM team_pair_rules WHERE team_name = $1`, teamName); err != nil {
+		return fmt.Errorf("delete rules: %w", err)
+	}
+	for _, rule := range rules {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO team_pair_rules (team_name, kind, reviewer_id, author_id)
+			VALUES ($1, $2, $3, $4)`, teamName, rule.Kind, rule.ReviewerID, rule.AuthorID)
+		if err != nil {
+			return fmt.Errorf("insert rule: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit: %w", err)
+	}
+	return nil
+}
+
+func (r *repo) GetPairRules(ctx context.Context, teamName string) ([]models.PairRule, error) {
+	var exists bool
+	err := r.db.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM teams WHERE team_name = $1)`, teamName).Scan(&exists)
+	if err != nil {
+		return nil, fmt.Errorf("check team exists: %w", err)
+	}
+	if !exists {
+		return nil, ErrTeamNotFound
+	}
+	return r.queryPairRules(ctx, `
+		SELECT kind, reviewer_id, author_id FROM team_pair_rules
+		WHERE team_name = $1 ORDER BY author_id, kind, reviewer_id`, teamName)
+}
+
+// GetAuthorPairRules returns the rules teamName set for pull requests by
+// authorID. Rules are scoped to the team reviewing the pull request, since
+// only its members can be candidates.
+func (r *repo) GetAuthorPairRules(ctx context.Context, teamName, authorID string) ([]models.PairRule, error) {
+	return r.queryPairRules(ctx, `
+		SELECT kind, reviewer_id, author_id FROM team_pair_rules
+		WHERE team_name = $1 AND author_id = $2
+		ORDER BY kind, reviewer_id`, teamName, authorID)
+}
+
+func (r *repo) queryPairRules(ctx context.Context, query string, args ...any) ([]models.PairRule, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query rules: %w", err)
+	}
+	defer rows.Close()
+
+	var rules []models.PairRule
+	for rows.Next() {
+		var rule models.PairRule
+		if err := rows.Scan(&rule.Kind, &rule.ReviewerID, &rule.AuthorID); err != nil {
+			return nil, fmt.Errorf("scan rule: %w", err)
+		}
+		rules = append(rules, rule)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return rules, nil
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+)
+
+// SetUserSchedule stores the working hours of a user; nil removes them.
+func (r *repo) SetUserSchedule(ctx context.Context, userID string, s *models.WorkSchedule) error {
+	var exists bool
+	err := r.db.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM users WHERE user_id = $1)`, userID).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("check user exists: %w", err)
+	}
+	if !exists {
+		return ErrUserNotFound
+	}
+
+	if s == nil {
+		if _, err := r.db.Exec(ctx, `DELETE FROM user_schedules WHERE user_id = $1`, userID); err != nil {
+			return fmt.Errorf("delete schedule: %w", err)
+		}
+		return nil
+	}
+	_, err = r.db.Exec(ctx, `
+		INSERT INTO user_schedules (user_id, timezone, work_start, work_end, work_days)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id) DO UPDATE
+		SET timezone = $2, work_start = $3, work_end = $4, work_days = $5, updated_at = NOW()`,
+		userID, s.Location.String(), s.Start, s.End, workDaysMask(s.Days))
+	if err != nil {
+		return fmt.Errorf("upsert schedule: %w", err)
+	}
+	return nil
+}
+
+// GetUserSchedules returns schedules keyed by user id; users without one are
+// omitted.
+func (r *repo) GetUserSchedules(ctx context.Context, userIDs []string) (map[string]*models.WorkSchedule, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT user_id, timezone, work_start, work_end, work_days
+		FROM user_schedules WHERE user_id = ANY($1)`, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("query schedules: %w", err)
+	}
+	defer rows.Close()
+
+	schedules := make(map[string]*models.WorkSchedule)
+	for rows.Next() {
+		var (
+			userID, tz       string
+			start, end, days int16
+		)
+		if err := rows.Scan(&userID, &tz, &start, &end, &days); err != nil {
+			return nil, fmt.Errorf("scan schedule: %w", err)
+		}
+		s, err := workSchedule(tz, start, end, days)
+		if err != nil {
+			return nil, err
+		}
+		schedules[userID] = s
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return schedules, nil
+}
+
+func workSchedule(tz string, start, end, days int16) (*models.WorkSchedule, error) {
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return nil, fmt.Errorf("load timezone %q: %w", tz, err)
+	}
+	s := &models.WorkSchedule{Location: loc, Start: int(start), End: int(end)}
+	for i := range s.Days {
+		s.Days[i] = days&(1<<i) != 0
+	}
+	return s, nil
+}
+
+func workDaysMask(days [7]bool) int16 {
+	var mask int16
+	for i, on := range days {
+		if on {
+			mask |= 1 << i
+		}
+	}
+	return mask
+}
@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+)
+
+func (r *repo) SetTeamSLA(ctx context.Context, sla models.TeamSLA) error {
+	var reassign *int64
+	if sla.ReassignAfter > 0 {
+		secs := int64(sla.ReassignAfter / time.Second)
+		reassign = &secs
+	}
+	tag, err := r.db.Exec(ctx, `
+		INSERT INTO team_settings (team_name, review_sla_seconds, reassign_after_seconds)
+		SELECT team_name, $2, $3 FROM teams WHERE team_name = $1
+		ON CONFLICT (team_name) DO UPDATE
+		SET review_sla_seconds = $2, reassign_after_seconds = $3, updated_at = NOW()`,
+		sla.TeamName, int64(sla.ReviewSLA/time.Second), reassign)
+	if err != nil {
+		return fmt.Errorf("set team sla: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrTeamNotFound
+	}
+	return nil
+}
+
+// GetTeamSLA returns the team's settings, or nil when it has none.
+func (r *repo) GetTeamSLA(ctx context.Context, teamName string) (*models.TeamSLA, error) {
+	var (
+		slaSecs      *int64
+		reassignSecs *int64
+	)
+	err := r.db.QueryRow(ctx, `
+		SELECT s.review_sla_seconds, s.reassign_after_seconds
+		FROM teams t LEFT JOIN team_settings s ON s.team_name = t.team_name
+		WHERE t.team_name = $1`, teamName).Scan(&slaSecs, &reassignSecs)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrTeamNotFound
+		}
+		return nil, fmt.Errorf("get team sla: %w", err)
+	}
+	return teamSLA(teamName, slaSecs, reassignSecs), nil
+}
+
+func teamSLA(teamName string, slaSecs, reassignSecs *int64) *models.TeamSLA {
+	if slaSecs == nil {
+		return nil
+	}
+	sla := &models.TeamSLA{TeamName: teamName, ReviewSLA: time.Duration(*slaSecs) * time.Second}
+	if reassignSecs != nil {
+		sla.ReassignAfter = time.Duration(*reassignSecs) * time.Second
+	}
+	return sla
+}
+
+// GetOpenAssignments returns assignments on open pull requests together with
+// the SLA settings of the reviewing team, oldest first. The reviewing team is
+// the one owning the repository or, without an owner, the author's team.
+func (r *repo) GetOpenAssignments(ctx context.Context, f models.OverdueFilter) ([]models.ReviewAssignment, error) {
+	var args queryArgs
+	where := []string{"a.ended_at IS NULL", "p.status = 'OPEN'"}
+	if f.TeamName != "" {
+		where = append(where, "COALESCE(r.team_name, u.team_name) = "+args.add(f.TeamName))
+	}
+	if f.ReviewerID != "" {
+		where = append(where, "a.user_id = "+args.add(f.ReviewerID))
+	}
+
+	query := fmt.Sprintf(`
+		SELECT a.id, a.pull_request_id, p.pull_request_name, p.author_id, COALESCE(r.team_name, u.team_name, ''),
+			a.user_id, a.assigned_at, a.reminded_at, a.escalated_at,
+			s.review_sla_seconds, s.reassign_after_seconds,
+			us.timezone, us.work_start, us.work_end, us.work_days
+		FROM review_assignments a
+		JOIN pull_requests p ON p.pull_request_id = a.pull_request_id
+		JOIN users u ON u.user_id = p.author_id
+		LEFT JOIN repositories r ON r.name = p.repository
+		LEFT JOIN team_settings s ON s.team_name = COALESCE(r.team_name, u.team_name)
+		LEFT JOIN user_schedules us ON us.user_id = a.user_id
+		WHERE %s
+		ORDER BY a.assigned_at, a.id`, strings.Join(where, " AND "))
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query open assignments: %w", err)
+	}
+	defer rows.Close()
+
+	var out []models.ReviewAssignment
+	for rows.Next() {
+		var (
+			a                     models.ReviewAssignment
+			slaSecs, reassignSecs *int64
+			tz                    *string
+			start, end, days      *int16
+		)
+		if err := rows.Scan(&a.ID, &a.PullRequestID, &a.PullRequestName, &a.AuthorID, &a.TeamName,
+			&a.ReviewerID, &a.AssignedAt, &a.RemindedAt, &a.EscalatedAt, &slaSecs, &reassignSecs,
+			&tz, &start, &end, &days); err != nil {
+			return nil, fmt.Errorf("scan assignment: %w", err)
+		}
+		a.SLA = teamSLA(a.TeamName, slaSecs, reassignSecs)
+		if tz != nil {
+			if a.ReviewerSchedule, err = workSchedule(*tz, *start, *end, *days); err != nil {
+				return nil, err
+			}
+		}
+		out = append(out, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return out, nil
+}
+
+func (r *repo) MarkReminded(ctx context.Context, ids []int64, at time.Time) error {
+	_, err := r.db.Exec(ctx, `UPDATE review_assignments SET reminded_at = $2 WHERE id = ANY($1)`, ids, at.UTC())
+	if err != nil {
+		return fmt.Errorf("mark reminded: %w", err)
+	}
+	return nil
+}
+
+func (r *repo) MarkEscalated(ctx context.Context, ids []int64, at time.Time) error {
+	_, err := r.db.Exec(ctx, `UPDATE review_assignments SET escalated_at = $2 WHERE id = ANY($1)`, ids, at.UTC())
+	if err != nil {
+		return fmt.Errorf("mark escalated: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+)
+
+// GetMemberLoads returns, for everyone active in the team at some point of
+// [from, to), the reviews assigned to them while they were in it and their
+// active time, ordered by user id. Reassigned reviews count for each
+// reviewer that held them.
+func (r *repo) GetMemberLoads(ctx context.Context, f models.FairnessFilter) ([]models.MemberLoad, error) {
+	rows, err := r.db.Query(ctx, `
+		WITH periods AS (
+			SELECT user_id, started_at, ended_at,
+				GREATEST(started_at, $2) AS s, LEAST(COALESCE(ended_at, $3), $3) AS e
+			FROM user_availability
+			WHERE team_name = $1 AND started_at < $3 AND (ended_at IS NULL OR ended_at > $2)
+		), active AS (
+			SELECT user_id, SUM(EXTRACT(EPOCH FROM e - s))::float8 AS secs
+			FROM periods GROUP BY user_id
+		), reviews AS (
+			SELECT a.user_id, COUNT(*) AS n
+			FROM review_assignments a
+			JOIN periods p ON p.user_id = a.user_id
+				AND a.assigned_at >= p.started_at AND (p.ended_at IS NULL OR a.assigned_at < p.ended_at)
+			WHERE a.assigned_at >= $2 AND a.assigned_at < $3
+			GROUP BY a.user_id
+		)
+		SELECT u.user_id, u.username, COALESCE(rv.n, 0), act.secs
+		FROM active act
+		JOIN users u ON u.user_id = act.user_id
+		LEFT JOIN reviews rv ON rv.user_id = act.user_id
+		ORDER BY u.user_id`, f.TeamName, f.From.UTC(), f.To.UTC())
+	if err != nil {
+		return nil, fmt.Errorf("query member loads: %w", err)
+	}
+	defer rows.Close()
+
+	var out []models.MemberLoad
+	for rows.Next() {
+		var (
+			l    models.MemberLoad
+			secs float64
+		)
+		if err := rows.Scan(&l.UserID, &l.Username, &l.Reviews, &secs); err != nil {
+			return nil, fmt.Errorf("scan member load: %w", err)
+		}
+		l.ActiveTime = time.Duration(secs * float64(time.Second))
+		out = append(out, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return out, nil
+}
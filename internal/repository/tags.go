@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+)
+
+// SetUserTags replaces the tags of a user and returns the stored set.
+func (r *repo) SetUserTags(ctx context.Context, userID string, tags []string) ([]string, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var exists bool
+	err = tx.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM users WHERE user_id = $1)`, userID).Scan(&exists)
+	if err != nil {
+		return nil, fmt.Errorf("check user exists: %w", err)
+	}
+	if !exists {
+		return nil, ErrUserNotFound
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM user_tags WHERE user_id = $1`, userID); err != nil {
+		return nil, fmt.Errorf("delete tags: %w", err)
+	}
+	if len(tags) > 0 {
+		_, err = tx.Exec(ctx, `
+			INSERT INTO user_tags (user_id, tag)
+			SELECT $1, t FROM unnest($2::text[]) AS t
+			ON CONFLICT DO NOTHING`, userID, tags)
+		if err != nil {
+			return nil, fmt.Errorf("insert tags: %w", err)
+		}
+	}
+
+	var stored []string
+	err = tx.QueryRow(ctx, `
+		SELECT COALESCE(array_agg(tag ORDER BY tag), '{}') FROM user_tags WHERE user_id = $1`, userID).Scan(&stored)
+	if err != nil {
+		return nil, fmt.Errorf("read tags: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit: %w", err)
+	}
+	return stored, nil
+}
+
+// GetUsersTags returns tags keyed by user id; users without tags are omitted.
+func (r *repo) GetUsersTags(ctx context.Context, userIDs []string) (map[string][]string, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT user_id, tag FROM user_tags WHERE user_id = ANY($1) ORDER BY user_id, tag`, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("query tags: %w", err)
+	}
+	defer rows.Close()
+
+	tags := make(map[string][]string)
+	for rows.Next() {
+		var userID, tag string
+		if err := rows.Scan(&userID, &tag); err != nil {
+			return nil, fmt.Errorf("scan tag: %w", err)
+		}
+		tags[userID] = append(tags[userID], tag)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return tags, nil
+}
@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+)
+
+// DetachUsers removes users from their teams and deactivates them. The rows
+// are kept so pull requests they authored or reviewed stay valid.
+func (r *repo) DetachUsers(ctx context.Context, userIDs []string) error {
+	_, err := r.db.Exec(ctx,
+		`UPDATE users SET team_name = NULL, is_active = false WHERE user_id = ANY($1)`, userIDs)
+	if err != nil {
+		return fmt.Errorf("detach users: %w", err)
+	}
+	return nil
+}
+
+func (r *repo) DeleteTeam(ctx context.Context, teamName string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx,
+		`UPDATE users SET team_name = NULL, is_active = false WHERE team_name = $1`, teamName)
+	if err != nil {
+		return fmt.Errorf("detach members: %w", err)
+	}
+
+	tag, err := tx.Exec(ctx, `DELETE FROM teams WHERE team_name = $1`, teamName)
+	if err != nil {
+		return fmt.Errorf("delete team: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrTeamNotFound
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *repo) RemoveReviewer(ctx context.Context, prID, userID string) (*models.PullRequest, error) {
+	pr, err := scanPR(r.db.QueryRow(ctx, `
+		UPDATE pull_requests
+		SET assigned_reviewers = array_remove(assigned_reviewers, $2)
+		WHERE pull_request_id = $1 AND status = 'OPEN' AND $2 = ANY(assigned_reviewers)
+		RETURNING `+prColumns, prID, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, r.reviewerConflict(ctx, prID)
+		}
+		return nil, fmt.Errorf("remove reviewer: %w", err)
+	}
+	return pr, nil
+}
+
+// GetOpenPRsByUsers returns open pull requests authored or reviewed by any of
+// the given users.
+func (r *repo) GetOpenPRsByUsers(ctx context.Context, userIDs []string) ([]models.PullRequest, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT `+prColumns+`
+		FROM pull_requests
+		WHERE status = 'OPEN' AND (author_id = ANY($1) OR assigned_reviewers && $1::text[])
+		ORDER BY pull_request_id`, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("query open prs: %w", err)
+	}
+	defer rows.Close()
+
+	return collectPRs(rows)
+}
+
+// MoveUser transfers a user to another team and records the move.
+func (r *repo) MoveUser(ctx context.Context, userID, teamName string, policy models.MovePolicy) (*models.User, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var fromTeam *string
+	err = tx.QueryRow(ctx, `SELECT team_name FROM users WHERE user_id = $1 FOR UPDATE`, userID).Scan(&fromTeam)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("lock user: %w", err)
+	}
+
+	var exists bool
+	err = tx.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM teams WHERE team_name = $1)`, teamName).Scan(&exists)
+	if err != nil {
+		return nil, fmt.Errorf("check team exists: %w", err)
+	}
+	if !exists {
+		return nil, ErrTeamNotFound
+	}
+
+	var u models.User
+	err = tx.QueryRow(ctx, `
+		UPDATE users SET team_name = $2 WHERE user_id = $1
+		RETURNING user_id, username, team_name, is_active`,
+		userID, teamName).Scan(&u.ID, &u.Username, &u.TeamName, &u.IsActive)
+	if err != nil {
+		return nil, fmt.Errorf("move user: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO user_team_history (user_id, from_team, to_team, review_policy)
+		VALUES ($1, $2, $3, $4)`, userID, fromTeam, teamName, string(policy))
+	if err != nil {
+		return nil, fmt.Errorf("record team move: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit: %w", err)
+	}
+	return &u, nil
+}
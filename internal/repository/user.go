@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+)
+
+func (r *repo) GetUserDetails(ctx context.Context, userID string) (*models.UserDetails, error) {
+	u := &models.UserDetails{}
+	err := r.db.QueryRow(ctx, `
+		SELECT u.user_id, u.username, COALESCE(u.team_name, ''), u.is_active, u.seniority,
+			(SELECT COALESCE(array_agg(t.tag ORDER BY t.tag), '{}') FROM user_tags t WHERE t.user_id = u.user_id),
+			(SELECT COUNT(*) FROM pr_reviewers rv WHERE rv.user_id = u.user_id AND rv.status = 'OPEN'),
+			(SELECT COALESCE(array_agg(p.pull_request_id ORDER BY p.pull_request_id), '{}')
+			 FROM pull_requests p WHERE p.author_id = u.user_id AND p.status = 'OPEN')
+		FROM users u WHERE u.user_id = $1`, userID).
+		Scan(&u.ID, &u.Username, &u.TeamName, &u.IsActive, &u.Seniority, &u.Tags, &u.OpenReviewCount, &u.AuthoredOpenPRs)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("get user: %w", err)
+	}
+	return u, nil
+}
+
+func (r *repo) ListUsers(ctx context.Context, f models.UserFilter) ([]models.User, error) {
+	var args queryArgs
+	where := []string{"TRUE"}
+	if f.TeamName != "" {
+		where = append(where, "team_name = "+args.add(f.TeamName))
+	}
+	if f.IsActive != nil {
+		where = append(where, "is_active = "+args.add(*f.IsActive))
+	}
+	if f.UsernamePrefix != "" {
+		where = append(where, "lower(username) LIKE "+args.add(strings.ToLower(escapeLike(f.UsernamePrefix))+"%"))
+	}
+	if f.AfterID != "" {
+		where = append(where, "user_id > "+args.add(f.AfterID))
+	}
+
+	query := fmt.Sprintf(`
+		SELECT user_id, username, COALESCE(team_name, ''), is_active
+		FROM users
+		WHERE %s
+		ORDER BY user_id
+		LIMIT %s`, strings.Join(where, " AND "), args.add(f.Limit))
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query users: %w", err)
+	}
+	defer rows.Close()
+
+	var users []models.User
+	for rows.Next() {
+		var u models.User
+		if err := rows.Scan(&u.ID, &u.Username, &u.TeamName, &u.IsActive); err != nil {
+			return nil, fmt.Errorf("scan user: %w", err)
+		}
+		users = append(users, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return users, nil
+}
@@ -0,0 +1,333 @@
+// Package roster reads and writes multi-team membership documents in YAML
+// and CSV, the formats teams are synced from HR spreadsheets in.
+//
+// YAML documents hold a list of teams:
+//
+//	teams:
+//	  - team_name: backend
+//	    members:
+//	      - user_id: u1
+//	        username: Alice
+//	        is_active: true
+//
+// CSV documents have a header row naming the team, user_id, username and
+// optional is_active columns, one member per row.
+//
+// Teams without members are left out of both formats: CSV cannot express
+// them, and importing a team requires members.
+package roster
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+)
+
+type Format string
+
+const (
+	FormatYAML Format = "yaml"
+	FormatCSV  Format = "csv"
+)
+
+var ErrUnknownFormat = errors.New("format must be yaml or csv")
+
+// ParseFormat accepts a format name or a media type.
+func ParseFormat(s string) (Format, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if i := strings.IndexByte(s, ';'); i >= 0 {
+		s = strings.TrimSpace(s[:i])
+	}
+	switch s {
+	case "yaml", "yml", "application/yaml", "application/x-yaml", "text/yaml", "text/x-yaml":
+		return FormatYAML, nil
+	case "csv", "text/csv":
+		return FormatCSV, nil
+	default:
+		return "", ErrUnknownFormat
+	}
+}
+
+// ContentType is the media type documents of the format are served with.
+func (f Format) ContentType() string {
+	if f == FormatCSV {
+		return "text/csv; charset=utf-8"
+	}
+	return "application/yaml; charset=utf-8"
+}
+
+type ParseError struct {
+	Line int
+	Msg  string
+}
+
+func (e *ParseError) Error() string {
+	if e.Line == 0 {
+		return e.Msg
+	}
+	return fmt.Sprintf("line %d: %s", e.Line, e.Msg)
+}
+
+// Decode reads teams in document order. Every member needs a valid user id
+// and a username, and a user may appear only once in the document.
+func Decode(r io.Reader, f Format) ([]models.Team, error) {
+	switch f {
+	case FormatYAML:
+		return decodeYAML(r)
+	case FormatCSV:
+		return decodeCSV(r)
+	default:
+		return nil, ErrUnknownFormat
+	}
+}
+
+func Encode(w io.Writer, f Format, teams []models.Team) error {
+	switch f {
+	case FormatYAML:
+		return encodeYAML(w, teams)
+	case FormatCSV:
+		return encodeCSV(w, teams)
+	default:
+		return ErrUnknownFormat
+	}
+}
+
+// builder collects teams and enforces the document-wide rules.
+type builder struct {
+	teams  []models.Team
+	index  map[string]int
+	seenAt map[string]int
+}
+
+func newBuilder() *builder {
+	return &builder{index: make(map[string]int), seenAt: make(map[string]int)}
+}
+
+func (b *builder) team(name string, line int) (*models.Team, error) {
+	if name == "" {
+		return nil, &ParseError{Line: line, Msg: "team name required"}
+	}
+	i, ok := b.index[name]
+	if !ok {
+		i = len(b.teams)
+		b.index[name] = i
+		b.teams = append(b.teams, models.Team{Name: name})
+	}
+	return &b.teams[i], nil
+}
+
+func (b *builder) member(t *models.Team, m models.Member, line int) error {
+	switch {
+	case m.ID == "":
+		return &ParseError{Line: line, Msg: "user_id required"}
+	case !models.ValidID(m.ID):
+		return &ParseError{Line: line, Msg: fmt.Sprintf("user_id %q may contain only letters, digits and . _ : -", m.ID)}
+	case m.Username == "":
+		return &ParseError{Line: line, Msg: fmt.Sprintf("username required for %s", m.ID)}
+	}
+	if prev, ok := b.seenAt[m.ID]; ok {
+		return &ParseError{Line: line, Msg: fmt.Sprintf("user %s already listed on line %d", m.ID, prev)}
+	}
+	b.seenAt[m.ID] = line
+	t.Members = append(t.Members, m)
+	return nil
+}
+
+type yamlDoc struct {
+	Teams []yaml.Node `yaml:"teams"`
+}
+
+type yamlTeam struct {
+	TeamName string      `yaml:"team_name"`
+	Members  []yaml.Node `yaml:"members"`
+}
+
+type yamlMember struct {
+	UserID   string `yaml:"user_id"`
+	Username string `yaml:"username"`
+	IsActive *bool  `yaml:"is_active,omitempty"`
+}
+
+func decodeYAML(r io.Reader) ([]models.Team, error) {
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+	var doc yamlDoc
+	if err := dec.Decode(&doc); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, &ParseError{Msg: "empty document"}
+		}
+		return nil, &ParseError{Msg: strings.TrimPrefix(err.Error(), "yaml: ")}
+	}
+
+	b := newBuilder()
+	for _, tn := range doc.Teams {
+		var yt yamlTeam
+		if err := checkKeys(&tn, "team_name", "members"); err != nil {
+			return nil, err
+		}
+		if err := tn.Decode(&yt); err != nil {
+			return nil, &ParseError{Line: tn.Line, Msg: strings.TrimPrefix(err.Error(), "yaml: ")}
+		}
+		team, err := b.team(yt.TeamName, tn.Line)
+		if err != nil {
+			return nil, err
+		}
+		for _, mn := range yt.Members {
+			var ym yamlMember
+			if err := checkKeys(&mn, "user_id", "username", "is_active"); err != nil {
+				return nil, err
+			}
+			if err := mn.Decode(&ym); err != nil {
+				return nil, &ParseError{Line: mn.Line, Msg: strings.TrimPrefix(err.Error(), "yaml: ")}
+			}
+			m := models.Member{ID: ym.UserID, Username: ym.Username, IsActive: ym.IsActive == nil || *ym.IsActive}
+			if err := b.member(team, m, mn.Line); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return b.teams, nil
+}
+
+// checkKeys rejects unknown mapping keys, which Node.Decode does not do even
+// when the outer decoder has KnownFields set.
+func checkKeys(n *yaml.Node, allowed ...string) error {
+	if n.Kind != yaml.MappingNode {
+		return &ParseError{Line: n.Line, Msg: "expected a mapping"}
+	}
+	for i := 0; i < len(n.Content); i += 2 {
+		key := n.Content[i]
+		if !slices.Contains(allowed, key.Value) {
+			return &ParseError{Line: key.Line, Msg: fmt.Sprintf("unknown field %q", key.Value)}
+		}
+	}
+	return nil
+}
+
+type yamlOutTeam struct {
+	TeamName string       `yaml:"team_name"`
+	Members  []yamlMember `yaml:"members"`
+}
+
+func encodeYAML(w io.Writer, teams []models.Team) error {
+	doc := struct {
+		Teams []yamlOutTeam `yaml:"teams"`
+	}{Teams: []yamlOutTeam{}}
+	for _, t := range teams {
+		if len(t.Members) == 0 {
+			continue
+		}
+		yt := yamlOutTeam{TeamName: t.Name, Members: []yamlMember{}}
+		for _, m := range t.Members {
+			active := m.IsActive
+			yt.Members = append(yt.Members, yamlMember{UserID: m.ID, Username: m.Username, IsActive: &active})
+		}
+		doc.Teams = append(doc.Teams, yt)
+	}
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+var csvColumns = []string{"team", "user_id", "username", "is_active"}
+
+func decodeCSV(r io.Reader) ([]models.Team, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, &ParseError{Msg: "empty document"}
+		}
+		return nil, csvError(err)
+	}
+	col := make(map[string]int, len(header))
+	for i, h := range header {
+		h = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(h, "\ufeff")))
+		if h == "team_name" {
+			h = "team"
+		}
+		col[h] = i
+	}
+	for _, name := range csvColumns[:3] {
+		if _, ok := col[name]; !ok {
+			return nil, &ParseError{Line: 1, Msg: fmt.Sprintf("missing %s column", name)}
+		}
+	}
+
+	b := newBuilder()
+	for {
+		rec, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, csvError(err)
+		}
+		line, _ := cr.FieldPos(0)
+		get := func(name string) string {
+			i, ok := col[name]
+			if !ok || i >= len(rec) {
+				return ""
+			}
+			return strings.TrimSpace(rec[i])
+		}
+		if strings.Join(rec, "") == "" {
+			continue
+		}
+
+		active := true
+		if v := get("is_active"); v != "" {
+			active, err = strconv.ParseBool(v)
+			if err != nil {
+				return nil, &ParseError{Line: line, Msg: "is_active must be true or false"}
+			}
+		}
+		team, err := b.team(get("team"), line)
+		if err != nil {
+			return nil, err
+		}
+		m := models.Member{ID: get("user_id"), Username: get("username"), IsActive: active}
+		if err := b.member(team, m, line); err != nil {
+			return nil, err
+		}
+	}
+	return b.teams, nil
+}
+
+func csvError(err error) error {
+	var pe *csv.ParseError
+	if errors.As(err, &pe) {
+		return &ParseError{Line: pe.Line, Msg: pe.Err.Error()}
+	}
+	return &ParseError{Msg: err.Error()}
+}
+
+func encodeCSV(w io.Writer, teams []models.Team) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvColumns); err != nil {
+		return err
+	}
+	for _, t := range teams {
+		for _, m := range t.Members {
+			if err := cw.Write([]string{t.Name, m.ID, m.Username, strconv.FormatBool(m.IsActive)}); err != nil {
+				return err
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,192 @@
+package roster
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+)
+
+func TestRoundTrip(t *testing.T) {
+	teams := []models.Team{
+		{Name: "backend", Members: []models.Member{
+			{ID: "u1", Username: "Alice", IsActive: true},
+			{ID: "u2", Username: "Bob, Jr.", IsActive: false},
+		}},
+		{Name: "frontend", Members: []models.Member{
+			{ID: "u3", Username: `Carol "CJ"`, IsActive: true},
+		}},
+	}
+	// Empty teams are not written, so exports can always be imported back.
+	exported := append(teams, models.Team{Name: "empty"})
+
+	for _, f := range []Format{FormatYAML, FormatCSV} {
+		t.Run(string(f), func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := Encode(&buf, f, exported); err != nil {
+				t.Fatal(err)
+			}
+			got, err := Decode(&buf, f)
+			if err != nil {
+				t.Fatalf("decode %s: %v\n%s", f, err, buf.String())
+			}
+			if !reflect.DeepEqual(got, teams) {
+				t.Errorf("round trip = %+v, want %+v", got, teams)
+			}
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name   string
+		format Format
+		doc    string
+		want   []models.Team
+		line   int
+		errMsg string
+	}{
+		{
+			name:   "csv defaults and column order",
+			format: FormatCSV,
+			doc:    "\ufeffUsername,Team_Name,user_id\nAlice,backend,u1\n\nBob,backend,u2\n",
+			want: []models.Team{{Name: "backend", Members: []models.Member{
+				{ID: "u1", Username: "Alice", IsActive: true},
+				{ID: "u2", Username: "Bob", IsActive: true},
+			}}},
+		},
+		{
+			name:   "csv missing column",
+			format: FormatCSV,
+			doc:    "team,user_id\nbackend,u1\n",
+			line:   1,
+			errMsg: "missing username column",
+		},
+		{
+			name:   "csv bad is_active",
+			format: FormatCSV,
+			doc:    "team,user_id,username,is_active\nbackend,u1,Alice,yes\n",
+			line:   2,
+			errMsg: "is_active must be true or false",
+		},
+		{
+			name:   "csv duplicate user",
+			format: FormatCSV,
+			doc:    "team,user_id,username\nbackend,u1,Alice\nfrontend,u1,Alice\n",
+			line:   3,
+			errMsg: "user u1 already listed on line 2",
+		},
+		{
+			name:   "csv invalid user id",
+			format: FormatCSV,
+			doc:    "team,user_id,username\nbackend,api#1,Alice\n",
+			line:   2,
+			errMsg: `user_id "api#1" may contain only letters, digits and . _ : -`,
+		},
+		{
+			name:   "csv empty",
+			format: FormatCSV,
+			doc:    "",
+			errMsg: "empty document",
+		},
+		{
+			name:   "yaml is_active defaults to true",
+			format: FormatYAML,
+			doc:    "teams:\n  - team_name: backend\n    members:\n      - user_id: u1\n        username: Alice\n",
+			want: []models.Team{{Name: "backend", Members: []models.Member{
+				{ID: "u1", Username: "Alice", IsActive: true},
+			}}},
+		},
+		{
+			name:   "yaml bad is_active",
+			format: FormatYAML,
+			doc:    "teams:\n  - team_name: backend\n    members:\n      - user_id: u1\n        username: Alice\n        is_active: maybe\n",
+			line:   4,
+		},
+		{
+			name:   "yaml duplicate user",
+			format: FormatYAML,
+			doc:    "teams:\n  - team_name: a\n    members:\n      - {user_id: u1, username: A}\n  - team_name: b\n    members:\n      - {user_id: u1, username: A}\n",
+			line:   7,
+			errMsg: "user u1 already listed on line 4",
+		},
+		{
+			name:   "yaml unknown team key",
+			format: FormatYAML,
+			doc:    "teams:\n  - team_name: backend\n    lead: u1\n",
+			line:   3,
+			errMsg: `unknown field "lead"`,
+		},
+		{
+			name:   "yaml unknown member key",
+			format: FormatYAML,
+			doc:    "teams:\n  - team_name: backend\n    members:\n      - user_id: u1\n        username: Alice\n        email: a@example.com\n",
+			line:   6,
+			errMsg: `unknown field "email"`,
+		},
+		{
+			name:   "yaml member not a mapping",
+			format: FormatYAML,
+			doc:    "teams:\n  - team_name: backend\n    members:\n      - u1\n",
+			line:   4,
+			errMsg: "expected a mapping",
+		},
+		{
+			name:   "yaml unknown top-level key",
+			format: FormatYAML,
+			doc:    "groups: []\n",
+		},
+		{
+			name:   "yaml missing username",
+			format: FormatYAML,
+			doc:    "teams:\n  - team_name: backend\n    members:\n      - user_id: u1\n",
+			line:   4,
+			errMsg: "username required for u1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Decode(strings.NewReader(tt.doc), tt.format)
+			if tt.want != nil {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("got %+v, want %+v", got, tt.want)
+				}
+				return
+			}
+			var perr *ParseError
+			if !errors.As(err, &perr) {
+				t.Fatalf("err = %v, want a *ParseError", err)
+			}
+			if perr.Line != tt.line {
+				t.Errorf("line = %d, want %d (%v)", perr.Line, tt.line, perr)
+			}
+			if tt.errMsg != "" && perr.Msg != tt.errMsg {
+				t.Errorf("msg = %q, want %q", perr.Msg, tt.errMsg)
+			}
+		})
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	tests := map[string]Format{
+		"yaml":                    FormatYAML,
+		"YML":                     FormatYAML,
+		"application/x-yaml":      FormatYAML,
+		"text/csv; charset=utf-8": FormatCSV,
+		" CSV ":                   FormatCSV,
+		"application/json":        "",
+		"":                        "",
+	}
+	for in, want := range tests {
+		got, err := ParseFormat(in)
+		if got != want || (want == "") != errors.Is(err, ErrUnknownFormat) {
+			t.Errorf("ParseFormat(%q) = %q, %v, want %q", in, got, err, want)
+		}
+	}
+}
@@ -6,21 +6,53 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/delivery"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/openapi"
 )
 
-func Router(h *delivery.Handler) *mux.Router {
+func Router(h *delivery.Handler, mws ...mux.MiddlewareFunc) *mux.Router {
 	r := mux.NewRouter()
+	r.Use(mws...)
 
 	r.HandleFunc("/team/add", h.AddTeam).Methods("POST")
 	r.HandleFunc("/team/get", h.GetTeam).Methods("GET")
+	r.HandleFunc("/team/update", h.UpdateTeam).Methods("PUT")
+	r.HandleFunc("/team/removeMember", h.RemoveTeamMember).Methods("POST")
+	r.HandleFunc("/team", h.DeleteTeam).Methods("DELETE")
+	r.HandleFunc("/team/codeowners", h.SetTeamCodeowners).Methods("POST")
+	r.HandleFunc("/team/import", h.ImportTeams).Methods("POST")
+	r.HandleFunc("/team/export", h.ExportTeams).Methods("GET")
+	r.HandleFunc("/team/rules", h.SetTeamRules).Methods("POST")
+	r.HandleFunc("/team/rules", h.GetTeamRules).Methods("GET")
+	r.HandleFunc("/team/mentorship", h.SetTeamMentorship).Methods("POST")
+	r.HandleFunc("/team/largePRs", h.SetTeamLargePRs).Methods("POST")
+	r.HandleFunc("/team/sla", h.SetTeamSLA).Methods("POST")
+	r.HandleFunc("/team/sla", h.GetTeamSLA).Methods("GET")
+	r.HandleFunc("/repository/set", h.SetRepository).Methods("POST")
+	r.HandleFunc("/repository/list", h.ListRepositories).Methods("GET")
+	r.HandleFunc("/users/get", h.GetUser).Methods("GET")
+	r.HandleFunc("/users/list", h.ListUsers).Methods("GET")
 	r.HandleFunc("/users/setIsActive", h.SetIsActive).Methods("POST")
+	r.HandleFunc("/users/setTags", h.SetTags).Methods("POST")
+	r.HandleFunc("/users/setSchedule", h.SetSchedule).Methods("POST")
+	r.HandleFunc("/users/setSeniority", h.SetSeniority).Methods("POST")
+	r.HandleFunc("/users/moveTeam", h.MoveUser).Methods("POST")
 	r.HandleFunc("/users/getReview", h.GetReviews).Methods("GET")
 
 	r.HandleFunc("/pullRequest/create", h.CreatePR).Methods("POST")
+	r.HandleFunc("/pullRequest/get", h.GetPR).Methods("GET")
+	r.HandleFunc("/pullRequest/list", h.ListPRs).Methods("GET")
+	r.HandleFunc("/pullRequest/ready", h.ReadyPR).Methods("POST")
 	r.HandleFunc("/pullRequest/merge", h.MergePR).Methods("POST")
 	r.HandleFunc("/pullRequest/reassign", h.Reassign).Methods("POST")
+	r.HandleFunc("/pullRequest/overdue", h.ListOverdue).Methods("GET")
+
+	r.HandleFunc("/events/stream", h.StreamEvents).Methods("GET")
+
+	r.HandleFunc("/stats/fairness", h.GetFairness).Methods("GET")
 
 	r.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) }).Methods("GET")
+	r.HandleFunc("/openapi.json", openapi.SpecHandler).Methods("GET")
+	r.HandleFunc("/docs", openapi.DocsHandler).Methods("GET")
 
 	return r
 }
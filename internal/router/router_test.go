@@ -0,0 +1,51 @@
+package router
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/delivery"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/openapi"
+)
+
+// TestRoutesMatchSpec keeps the router and openapi.json in sync: every routed
+// path and method must be documented, and every documented operation routed.
+func TestRoutesMatchSpec(t *testing.T) {
+	doc, err := openapi.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	routed := make(map[string]bool)
+	err = Router(&delivery.Handler{}).Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+		path, err := route.GetPathTemplate()
+		if err != nil {
+			return err
+		}
+		methods, err := route.GetMethods()
+		if err != nil {
+			t.Errorf("route %s has no methods", path)
+			return nil
+		}
+		for _, m := range methods {
+			routed[m+" "+path] = true
+			if item := doc.Paths.Find(path); item == nil || item.GetOperation(m) == nil {
+				t.Errorf("%s %s is routed but missing from openapi.json", m, path)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for path, item := range doc.Paths.Map() {
+		for method := range item.Operations() {
+			if !routed[strings.ToUpper(method)+" "+path] {
+				t.Errorf("%s %s is in openapi.json but not routed", strings.ToUpper(method), path)
+			}
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package scheduler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+)
+
+// Notifier delivers reminders about overdue reviews.
+type Notifier interface {
+	Notify(ctx context.Context, overdue []models.OverdueReview) error
+}
+
+type LogNotifier struct {
+	logger *slog.Logger
+}
+
+func NewLogNotifier(logger *slog.Logger) *LogNotifier {
+	return &LogNotifier{logger: logger}
+}
+
+func (n *LogNotifier) Notify(_ context.Context, overdue []models.OverdueReview) error {
+	for _, o := range overdue {
+		n.logger.Warn("review overdue",
+			"pr_id", o.PullRequestID, "reviewer_id", o.ReviewerID, "assigned_at", o.AssignedAt, "overdue_by", o.OverdueBy.String())
+	}
+	return nil
+}
+
+// WebhookNotifier posts reminders as a JSON batch to a URL.
+type WebhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+func NewWebhookNotifier(url string) *WebhookNotifier {
+	return &WebhookNotifier{url: url, client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+type webhookReminder struct {
+	PullRequestID   string    `json:"pull_request_id"`
+	PullRequestName string    `json:"pull_request_name"`
+	AuthorID        string    `json:"author_id"`
+	TeamName        string    `json:"team_name"`
+	ReviewerID      string    `json:"reviewer_id"`
+	AssignedAt      time.Time `json:"assigned_at"`
+	DueAt           time.Time `json:"due_at"`
+}
+
+func (n *WebhookNotifier) Notify(ctx context.Context, overdue []models.OverdueReview) error {
+	reminders := make([]webhookReminder, 0, len(overdue))
+	for _, o := range overdue {
+		reminders = append(reminders, webhookReminder{
+			PullRequestID:   o.PullRequestID,
+			PullRequestName: o.PullRequestName,
+			AuthorID:        o.AuthorID,
+			TeamName:        o.TeamName,
+			ReviewerID:      o.ReviewerID,
+			AssignedAt:      o.AssignedAt,
+			DueAt:           o.DueAt,
+		})
+	}
+	body, err := json.Marshal(map[string]any{"type": models.EventReviewOverdue, "reviews": reminders})
+	if err != nil {
+		return fmt.Errorf("marshal reminders: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("send webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+// Package scheduler runs periodic background jobs of the service.
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/repository"
+)
+
+// slaLockKey is the advisory lock key that keeps the SLA check on a single
+// replica at a time.
+const slaLockKey int64 = 0x70725f736c61 // "pr_sla"
+
+type OverdueProcessor interface {
+	ProcessOverdue(ctx context.Context) (*models.SLARun, error)
+}
+
+// SLAScheduler periodically reminds reviewers about overdue reviews and
+// reassigns the ones past the team's threshold. Review events are published
+// by the service; the notifier is an extra channel for the reminders.
+type SLAScheduler struct {
+	service  OverdueProcessor
+	locker   repository.Locker
+	notifier Notifier
+	interval time.Duration
+	logger   *slog.Logger
+}
+
+func NewSLAScheduler(service OverdueProcessor, locker repository.Locker, notifier Notifier, interval time.Duration, logger *slog.Logger) *SLAScheduler {
+	if notifier == nil {
+		notifier = NewLogNotifier(logger)
+	}
+	return &SLAScheduler{
+		service:  service,
+		locker:   locker,
+		notifier: notifier,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Run checks overdue reviews every interval until ctx is done.
+func (s *SLAScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.tick(ctx)
+		}
+	}
+}
+
+func (s *SLAScheduler) tick(ctx context.Context) {
+	unlock, ok, err := s.locker.TryLock(ctx, slaLockKey)
+	if err != nil {
+		s.logger.Error("sla lock failed", "err", err)
+		return
+	}
+	if !ok {
+		// Another replica is running the check.
+		return
+	}
+	defer unlock()
+
+	run, err := s.service.ProcessOverdue(ctx)
+	if err != nil {
+		s.logger.Error("sla check failed", "err", err)
+		return
+	}
+	if len(run.Reminded) > 0 {
+		if err := s.notifier.Notify(ctx, run.Reminded); err != nil {
+			s.logger.Error("sla notify failed", "count", len(run.Reminded), "err", err)
+		}
+	}
+	if len(run.Reminded) > 0 || len(run.Reassigned) > 0 || run.Failed > 0 {
+		s.logger.Info("sla check done",
+			"reminded", len(run.Reminded), "reassigned", len(run.Reassigned), "failed", run.Failed)
+	}
+}
@@ -0,0 +1,58 @@
+package server
+
+import (
+	"log/slog"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+
+	pb "github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/pb/prservice/v1"
+)
+
+type GRPCServer struct {
+	addr   string
+	srv    *grpc.Server
+	health *health.Server
+	logger *slog.Logger
+}
+
+func NewGRPCServer(addr string, service pb.PRServiceServer, logger *slog.Logger) *GRPCServer {
+	srv := grpc.NewServer()
+	pb.RegisterPRServiceServer(srv, service)
+
+	hs := health.NewServer()
+	healthpb.RegisterHealthServer(srv, hs)
+	hs.SetServingStatus(pb.PRService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+	reflection.Register(srv)
+
+	return &GRPCServer{addr: addr, srv: srv, health: hs, logger: logger}
+}
+
+// Serve blocks serving on lis; it is split out of Start so tests can use an
+// in-memory listener.
+func (s *GRPCServer) Serve(lis net.Listener) error {
+	return s.srv.Serve(lis)
+}
+
+func (s *GRPCServer) Start() error {
+	lis, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		return err
+	}
+	go func() {
+		s.logger.Info("grpc server starting", "addr", s.addr)
+		if err := s.Serve(lis); err != nil {
+			s.logger.Error("grpc server failed", "err", err)
+		}
+	}()
+	return nil
+}
+
+func (s *GRPCServer) Stop() {
+	s.health.Shutdown()
+	s.srv.GracefulStop()
+	s.logger.Info("grpc server stopped")
+}
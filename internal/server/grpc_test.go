@@ -0,0 +1,435 @@
+package server
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/delivery/grpcapi"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+	pb "github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/pb/prservice/v1"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/repository"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/usecase"
+)
+
+// fakeService implements only the calls the tests make; any other call
+// panics on the nil embedded interface.
+type fakeService struct {
+	usecase.PRService
+	prs     map[string]*models.PullRequest
+	created models.PullRequest
+	reviews models.ReviewFilter
+	repos   []models.Repository
+	rules   []models.PairRule
+}
+
+func (f *fakeService) CreateTeam(_ context.Context, team models.Team) (*models.Team, error) {
+	if team.Name == "" {
+		return nil, &usecase.InvalidArgumentError{Msg: "team name required"}
+	}
+	return &team, nil
+}
+
+func (f *fakeService) CreatePR(_ context.Context, pr models.PullRequest) (*models.PullRequest, error) {
+	f.created = pr
+	pr.Status = "OPEN"
+	if !pr.IsDraft {
+		pr.AssignedReviewers = []string{"u2", "u3"}
+		pr.PolicyViolations = []string{models.ViolationNoSenior}
+	}
+	return &pr, nil
+}
+
+func (f *fakeService) ReadyPR(_ context.Context, prID string) (*models.PullRequest, error) {
+	pr, ok := f.prs[prID]
+	if !ok {
+		return nil, repository.ErrPRNotFound
+	}
+	if pr.Status == "MERGED" {
+		return nil, usecase.ErrPRMerged
+	}
+	ready := *pr
+	ready.IsDraft = false
+	ready.AssignedReviewers = []string{"u2"}
+	return &ready, nil
+}
+
+func (f *fakeService) SetUserSeniority(_ context.Context, _, seniority string) error {
+	if seniority != models.SenioritySenior {
+		return &usecase.InvalidArgumentError{Msg: "unknown seniority"}
+	}
+	return nil
+}
+
+func (f *fakeService) GetPR(_ context.Context, prID string) (*models.PullRequest, error) {
+	pr, ok := f.prs[prID]
+	if !ok {
+		return nil, repository.ErrPRNotFound
+	}
+	return pr, nil
+}
+
+func (f *fakeService) GetUserReviews(_ context.Context, _ string, filter models.ReviewFilter) ([]models.PRShort, *models.Cursor, error) {
+	f.reviews = filter
+	return []models.PRShort{{ID: "pr-1", Name: "Add search", AuthorID: "u1", Status: "OPEN", Repository: filter.Repository, Labels: []string{filter.Label}}}, nil, nil
+}
+
+func (f *fakeService) SetRepository(_ context.Context, repo models.Repository) (*models.Repository, error) {
+	if repo.TeamName == "missing" {
+		return nil, repository.ErrTeamNotFound
+	}
+	f.repos = append(f.repos, repo)
+	return &repo, nil
+}
+
+func (f *fakeService) ListRepositories(context.Context) ([]models.Repository, error) {
+	return f.repos, nil
+}
+
+func (f *fakeService) MoveUser(_ context.Context, userID, teamName string, _ models.MovePolicy) (*models.User, *models.ReviewHandover, error) {
+	if teamName == "backend" {
+		return nil, nil, usecase.ErrAlreadyInTeam
+	}
+	user := &models.User{ID: userID, Username: "alice", TeamName: teamName, IsActive: true}
+	return user, &models.ReviewHandover{Reassigned: []string{"pr-1"}, Removed: []string{"pr-2"}}, nil
+}
+
+func (f *fakeService) SetPairRules(_ context.Context, teamName string, rules []models.PairRule) ([]models.PairRule, error) {
+	if teamName == "missing" {
+		return nil, repository.ErrTeamNotFound
+	}
+	for _, r := range rules {
+		if r.ReviewerID == "x9" {
+			return nil, &usecase.NotTeamMemberError{UserID: r.ReviewerID}
+		}
+	}
+	f.rules = rules
+	return f.rules, nil
+}
+
+func (f *fakeService) GetPairRules(_ context.Context, teamName string) ([]models.PairRule, error) {
+	if teamName == "missing" {
+		return nil, repository.ErrTeamNotFound
+	}
+	return f.rules, nil
+}
+
+func startServer(t *testing.T, service usecase.PRService) *grpc.ClientConn {
+	t.Helper()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	srv := NewGRPCServer("", grpcapi.NewService(service, logger), logger)
+
+	lis := bufconn.Listen(1 << 20)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func TestHealth(t *testing.T) {
+	conn := startServer(t, &fakeService{})
+	resp, err := healthpb.NewHealthClient(conn).Check(context.Background(),
+		&healthpb.HealthCheckRequest{Service: pb.PRService_ServiceDesc.ServiceName})
+	if err != nil {
+		t.Fatalf("check: %v", err)
+	}
+	if resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+		t.Errorf("status = %v, want SERVING", resp.GetStatus())
+	}
+}
+
+func TestCreatePullRequest(t *testing.T) {
+	fake := &fakeService{}
+	client := pb.NewPRServiceClient(startServer(t, fake))
+
+	resp, err := client.CreatePullRequest(context.Background(), &pb.CreatePullRequestRequest{
+		PullRequestId:   "pr-1",
+		PullRequestName: "Add search",
+		AuthorId:        "u1",
+		ChangedFiles:    []string{"internal/search.go"},
+		RequiredTags:    []string{"go"},
+	})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if fake.created.ID != "pr-1" || fake.created.AuthorID != "u1" ||
+		!slices.Equal(fake.created.ChangedFiles, []string{"internal/search.go"}) ||
+		!slices.Equal(fake.created.RequiredTags, []string{"go"}) {
+		t.Errorf("service got %+v", fake.created)
+	}
+	pr := resp.GetPr()
+	if pr.GetStatus() != pb.PullRequestStatus_PULL_REQUEST_STATUS_OPEN {
+		t.Errorf("status = %v, want OPEN", pr.GetStatus())
+	}
+	if !slices.Equal(pr.GetAssignedReviewers(), []string{"u2", "u3"}) {
+		t.Errorf("reviewers = %v, want [u2 u3]", pr.GetAssignedReviewers())
+	}
+	if !slices.Equal(pr.GetPolicyViolations(), []string{models.ViolationNoSenior}) {
+		t.Errorf("policy violations = %v", pr.GetPolicyViolations())
+	}
+}
+
+func TestPullRequestMetadata(t *testing.T) {
+	fake := &fakeService{}
+	client := pb.NewPRServiceClient(startServer(t, fake))
+	ctx := context.Background()
+
+	resp, err := client.CreatePullRequest(ctx, &pb.CreatePullRequestRequest{
+		PullRequestId:   "pr-1",
+		PullRequestName: "Add search",
+		AuthorId:        "u1",
+		Repository:      "api",
+		Url:             "https://git.example.com/api/pull/1",
+		Labels:          []string{"feature"},
+		LinesAdded:      120,
+		LinesRemoved:    30,
+		Description:     "Full text search",
+	})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	want := models.PullRequest{
+		ID: "pr-1", Name: "Add search", AuthorID: "u1", Repository: "api",
+		URL: "https://git.example.com/api/pull/1", Labels: []string{"feature"},
+		LinesAdded: 120, LinesRemoved: 30, Description: "Full text search",
+	}
+	if !reflect.DeepEqual(fake.created, want) {
+		t.Errorf("service got %+v, want %+v", fake.created, want)
+	}
+	pr := resp.GetPr()
+	if pr.GetRepository() != "api" || pr.GetUrl() != want.URL || !slices.Equal(pr.GetLabels(), want.Labels) ||
+		pr.GetLinesAdded() != 120 || pr.GetLinesRemoved() != 30 || pr.GetDescription() != want.Description {
+		t.Errorf("pr = %v", pr)
+	}
+
+	reviews, err := client.GetUserReviews(ctx, &pb.GetUserReviewsRequest{UserId: "u2", Repository: "api", Label: "feature"})
+	if err != nil {
+		t.Fatalf("reviews: %v", err)
+	}
+	if fake.reviews.Repository != "api" || fake.reviews.Label != "feature" {
+		t.Errorf("filter = %+v", fake.reviews)
+	}
+	if got := reviews.GetPullRequests(); len(got) != 1 || got[0].GetRepository() != "api" || !slices.Equal(got[0].GetLabels(), []string{"feature"}) {
+		t.Errorf("reviews = %v", got)
+	}
+}
+
+func TestRepositories(t *testing.T) {
+	client := pb.NewPRServiceClient(startServer(t, &fakeService{}))
+	ctx := context.Background()
+
+	set, err := client.SetRepository(ctx, &pb.SetRepositoryRequest{Repository: &pb.Repository{Repository: "api", TeamName: "platform"}})
+	if err != nil {
+		t.Fatalf("set: %v", err)
+	}
+	if set.GetRepository().GetRepository() != "api" || set.GetRepository().GetTeamName() != "platform" {
+		t.Errorf("set = %v", set.GetRepository())
+	}
+	_, err = client.SetRepository(ctx, &pb.SetRepositoryRequest{Repository: &pb.Repository{Repository: "web", TeamName: "missing"}})
+	if got := status.Code(err); got != codes.NotFound {
+		t.Errorf("unknown team: code = %v, want NotFound", got)
+	}
+
+	list, err := client.ListRepositories(ctx, &pb.ListRepositoriesRequest{})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if got := list.GetRepositories(); len(got) != 1 || got[0].GetRepository() != "api" {
+		t.Errorf("repositories = %v", got)
+	}
+}
+
+func TestDraftPullRequest(t *testing.T) {
+	fake := &fakeService{prs: map[string]*models.PullRequest{
+		"pr-1": {ID: "pr-1", Status: "OPEN", IsDraft: true},
+		"pr-2": {ID: "pr-2", Status: "MERGED"},
+	}}
+	client := pb.NewPRServiceClient(startServer(t, fake))
+	ctx := context.Background()
+
+	created, err := client.CreatePullRequest(ctx, &pb.CreatePullRequestRequest{
+		PullRequestId: "pr-1", PullRequestName: "Draft", AuthorId: "u1", IsDraft: true,
+	})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if !fake.created.IsDraft || !created.GetPr().GetIsDraft() || len(created.GetPr().GetAssignedReviewers()) != 0 {
+		t.Errorf("created %v from %+v, want a draft without reviewers", created.GetPr(), fake.created)
+	}
+
+	ready, err := client.ReadyPullRequest(ctx, &pb.ReadyPullRequestRequest{PullRequestId: "pr-1"})
+	if err != nil {
+		t.Fatalf("ready: %v", err)
+	}
+	if ready.GetPr().GetIsDraft() || !slices.Equal(ready.GetPr().GetAssignedReviewers(), []string{"u2"}) {
+		t.Errorf("ready = %v", ready.GetPr())
+	}
+
+	_, err = client.ReadyPullRequest(ctx, &pb.ReadyPullRequestRequest{PullRequestId: "pr-2"})
+	if got := status.Code(err); got != codes.FailedPrecondition {
+		t.Errorf("ready merged pr: code = %v, want FailedPrecondition", got)
+	}
+}
+
+func TestSetUserSeniority(t *testing.T) {
+	client := pb.NewPRServiceClient(startServer(t, &fakeService{}))
+
+	resp, err := client.SetUserSeniority(context.Background(), &pb.SetUserSeniorityRequest{UserId: "u1", Seniority: models.SenioritySenior})
+	if err != nil {
+		t.Fatalf("set seniority: %v", err)
+	}
+	if resp.GetUserId() != "u1" || resp.GetSeniority() != models.SenioritySenior {
+		t.Errorf("response = %v", resp)
+	}
+	_, err = client.SetUserSeniority(context.Background(), &pb.SetUserSeniorityRequest{UserId: "u1", Seniority: "lead"})
+	if got := status.Code(err); got != codes.InvalidArgument {
+		t.Errorf("code = %v, want InvalidArgument", got)
+	}
+}
+
+func TestGetPullRequestExplanations(t *testing.T) {
+	chosen := time.Date(2025, 3, 7, 10, 0, 0, 0, time.UTC)
+	fake := &fakeService{prs: map[string]*models.PullRequest{"pr-1": {
+		ID:                "pr-1",
+		Status:            "OPEN",
+		AssignedReviewers: []string{"u2"},
+		Explanations: []models.ReviewerExplanation{{
+			ReviewerID:     "u2",
+			Strategy:       models.StrategyRequiredTags,
+			PoolSize:       2,
+			Excluded:       []models.Exclusion{{UserID: "u1", Reason: models.ExclusionAuthor}},
+			MatchedTags:    []string{"go"},
+			InWorkingHours: true,
+			Score:          101,
+			Seed:           42,
+			CreatedAt:      chosen,
+		}},
+	}}}
+	client := pb.NewPRServiceClient(startServer(t, fake))
+
+	resp, err := client.GetPullRequest(context.Background(), &pb.GetPullRequestRequest{PullRequestId: "pr-1"})
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	got := resp.GetPr().GetExplanations()
+	if len(got) != 1 {
+		t.Fatalf("explanations = %v, want one", got)
+	}
+	e := got[0]
+	if e.GetReviewerId() != "u2" || e.GetStrategy() != models.StrategyRequiredTags || e.GetPoolSize() != 2 ||
+		e.GetScore() != 101 || e.GetSeed() != 42 || !e.GetInWorkingHours() ||
+		!slices.Equal(e.GetMatchedTags(), []string{"go"}) || !e.GetChosenAt().AsTime().Equal(chosen) {
+		t.Errorf("explanation = %v", e)
+	}
+	if x := e.GetExcluded(); len(x) != 1 || x[0].GetUserId() != "u1" || x[0].GetReason() != models.ExclusionAuthor {
+		t.Errorf("excluded = %v", x)
+	}
+}
+
+func TestMoveUser(t *testing.T) {
+	client := pb.NewPRServiceClient(startServer(t, &fakeService{}))
+
+	resp, err := client.MoveUser(context.Background(), &pb.MoveUserRequest{UserId: "u1", TeamName: "frontend"})
+	if err != nil {
+		t.Fatalf("move: %v", err)
+	}
+	if resp.GetUser().GetTeamName() != "frontend" {
+		t.Errorf("team = %q, want frontend", resp.GetUser().GetTeamName())
+	}
+	if !slices.Equal(resp.GetReassignedPrs(), []string{"pr-1"}) || !slices.Equal(resp.GetRemovedPrs(), []string{"pr-2"}) {
+		t.Errorf("reassigned = %v, removed = %v", resp.GetReassignedPrs(), resp.GetRemovedPrs())
+	}
+}
+
+func TestTeamRules(t *testing.T) {
+	client := pb.NewPRServiceClient(startServer(t, &fakeService{}))
+	ctx := context.Background()
+
+	rules := []*pb.PairRule{
+		{Type: models.PairAlways, ReviewerId: "u2", AuthorId: "u1"},
+		{Type: models.PairNever, ReviewerId: "u3", AuthorId: "u1"},
+	}
+	set, err := client.SetTeamRules(ctx, &pb.SetTeamRulesRequest{TeamName: "backend", Rules: rules})
+	if err != nil {
+		t.Fatalf("set rules: %v", err)
+	}
+	got, err := client.GetTeamRules(ctx, &pb.GetTeamRulesRequest{TeamName: "backend"})
+	if err != nil {
+		t.Fatalf("get rules: %v", err)
+	}
+	for _, resp := range []*pb.TeamRulesResponse{set, got} {
+		if resp.GetTeamName() != "backend" || len(resp.GetRules()) != 2 {
+			t.Fatalf("response = %v", resp)
+		}
+		if r := resp.GetRules()[0]; r.GetType() != models.PairAlways || r.GetReviewerId() != "u2" || r.GetAuthorId() != "u1" {
+			t.Errorf("first rule = %v", r)
+		}
+	}
+
+	_, err = client.SetTeamRules(ctx, &pb.SetTeamRulesRequest{TeamName: "backend", Rules: []*pb.PairRule{
+		{Type: models.PairNever, ReviewerId: "x9", AuthorId: "u1"},
+	}})
+	if got := status.Code(err); got != codes.NotFound {
+		t.Errorf("non-member code = %v, want NotFound", got)
+	}
+	_, err = client.GetTeamRules(ctx, &pb.GetTeamRulesRequest{TeamName: "missing"})
+	if got := status.Code(err); got != codes.NotFound {
+		t.Errorf("missing team code = %v, want NotFound", got)
+	}
+}
+
+func TestErrorCodes(t *testing.T) {
+	client := pb.NewPRServiceClient(startServer(t, &fakeService{}))
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		call func() error
+		want codes.Code
+	}{
+		{"invalid argument", func() error {
+			_, err := client.CreateTeam(ctx, &pb.CreateTeamRequest{Team: &pb.Team{}})
+			return err
+		}, codes.InvalidArgument},
+		{"not found", func() error {
+			_, err := client.GetPullRequest(ctx, &pb.GetPullRequestRequest{PullRequestId: "missing"})
+			return err
+		}, codes.NotFound},
+		{"failed precondition", func() error {
+			_, err := client.MoveUser(ctx, &pb.MoveUserRequest{UserId: "u1", TeamName: "backend"})
+			return err
+		}, codes.FailedPrecondition},
+		{"bad cursor", func() error {
+			_, err := client.ListUsers(ctx, &pb.ListUsersRequest{Cursor: "%%%"})
+			return err
+		}, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := status.Code(tt.call()); got != tt.want {
+				t.Errorf("code = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/codeowners"
+)
+
+func (s *prService) SetTeamCodeowners(ctx context.Context, teamName, content string) (*codeowners.Ruleset, error) {
+	if teamName == "" {
+		s.logger.Warn("invalid team name")
+		return nil, invalidArgument("team name required")
+	}
+
+	rs, err := codeowners.Parse(strings.NewReader(content))
+	if err != nil {
+		s.logger.Warn("invalid codeowners", "team", teamName, "err", err)
+		return nil, fmt.Errorf("parse codeowners: %w", err)
+	}
+
+	if _, err := s.repo.GetTeam(ctx, teamName); err != nil {
+		s.logger.Error("get team failed", "err", err)
+		return nil, fmt.Errorf("get team: %w", err)
+	}
+
+	if err := s.repo.SetTeamCodeowners(ctx, teamName, content); err != nil {
+		s.logger.Error("set codeowners failed", "err", err)
+		return nil, fmt.Errorf("set codeowners: %w", err)
+	}
+	return rs, nil
+}
+
+// pathOwners returns the owners of the changed files according to the team
+// rules, most files owned first. Ties keep the order of first appearance.
+func (s *prService) pathOwners(ctx context.Context, teamName string, files []string) ([]string, error) {
+	if len(files) == 0 {
+		return nil, nil
+	}
+
+	content, err := s.repo.GetTeamCodeowners(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	if content == "" {
+		return nil, nil
+	}
+
+	rs, err := codeowners.Parse(strings.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("parse stored codeowners: %w", err)
+	}
+
+	var owners []string
+	counts := make(map[string]int)
+	for _, f := range files {
+		for _, o := range rs.Match(f) {
+			if counts[o] == 0 {
+				owners = append(owners, o)
+			}
+			counts[o]++
+		}
+	}
+	sort.SliceStable(owners, func(i, j int) bool { return counts[owners[i]] > counts[owners[j]] })
+	return owners, nil
+}
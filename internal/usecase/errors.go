@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/repository"
+)
+
+var (
+	// ErrInvalidArgument marks input rejected by the service; it maps to 400.
+	ErrInvalidArgument = errors.New("INVALID_ARGUMENT")
+
+	ErrTeamExists = errors.New("TEAM_EXISTS")
+	ErrPRExists   = errors.New("PR_EXISTS")
+
+	// ErrPRMerged and ErrNotAssigned are also returned by the repository when
+	// a pull request changes under a concurrent request.
+	ErrPRMerged    = repository.ErrPRMerged
+	ErrNotAssigned = repository.ErrNotAssigned
+
+	ErrNotTeamMember = errors.New("NOT_TEAM_MEMBER")
+	ErrInvalidPolicy = errors.New("INVALID_POLICY")
+	ErrAlreadyInTeam = errors.New("ALREADY_IN_TEAM")
+	ErrInvalidFilter = errors.New("INVALID_FILTER")
+
+	// ErrRulesUnsatisfiable is returned when the team's pair rules cannot be
+	// met by the available reviewers.
+	ErrRulesUnsatisfiable = errors.New("RULES_UNSATISFIABLE")
+)
+
+// OpenPRsError is returned when users cannot leave a team because they still
+// author or review open pull requests and the policy forbids reassignment.
+type OpenPRsError struct {
+	PullRequestIDs []string
+}
+
+func (e *OpenPRsError) Error() string {
+	return fmt.Sprintf("users have open pull requests: %s", strings.Join(e.PullRequestIDs, ", "))
+}
+
+// InvalidArgumentError is input rejected by the service. Msg is meant for the
+// client; the error matches ErrInvalidArgument.
+type InvalidArgumentError struct {
+	Msg string
+}
+
+func invalidArgument(format string, args ...any) error {
+	return &InvalidArgumentError{Msg: fmt.Sprintf(format, args...)}
+}
+
+func (e *InvalidArgumentError) Error() string {
+	return "invalid argument: " + e.Msg
+}
+
+func (e *InvalidArgumentError) Is(target error) bool {
+	return target == ErrInvalidArgument
+}
+
+// RulesUnsatisfiableError explains which pair rule cannot be met; it matches
+// ErrRulesUnsatisfiable.
+type RulesUnsatisfiableError struct {
+	Msg string
+}
+
+func (e *RulesUnsatisfiableError) Error() string {
+	return "rules unsatisfiable: " + e.Msg
+}
+
+func (e *RulesUnsatisfiableError) Is(target error) bool {
+	return target == ErrRulesUnsatisfiable
+}
+
+// NotTeamMemberError names the user that is not a member of the team; it
+// matches ErrNotTeamMember.
+type NotTeamMemberError struct {
+	UserID string
+}
+
+func (e *NotTeamMemberError) Error() string {
+	return "not a team member: " + e.UserID
+}
+
+func (e *NotTeamMemberError) Is(target error) bool {
+	return target == ErrNotTeamMember
+}
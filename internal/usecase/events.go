@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+)
+
+// EventPublisher records assignment events. It is satisfied by events.Hub.
+type EventPublisher interface {
+	Publish(ctx context.Context, events []models.Event) error
+}
+
+// publish records events once the change they describe is committed. The
+// change cannot be rolled back at this point, so a failure is only logged.
+func (s *prService) publish(ctx context.Context, events ...models.Event) {
+	if s.events == nil || len(events) == 0 {
+		return
+	}
+	if err := s.events.Publish(context.WithoutCancel(ctx), events); err != nil {
+		s.logger.Error("publish events failed", "count", len(events), "err", err)
+	}
+}
+
+func reviewEvents(typ string, pr *models.PullRequest, userIDs ...string) []models.Event {
+	events := make([]models.Event, 0, len(userIDs))
+	for _, id := range userIDs {
+		events = append(events, models.Event{
+			Type:          typ,
+			UserID:        id,
+			PullRequestID: pr.ID,
+			AuthorID:      pr.AuthorID,
+		})
+	}
+	return events
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+)
+
+// exclusions lists the members of team that were not candidates. reasons
+// gives the reason for specific users; any other member left out is
+// inactive.
+func exclusions(team *models.Team, candidates []string, reasons map[string]string) []models.Exclusion {
+	if team == nil {
+		return nil
+	}
+	isCandidate := make(map[string]bool, len(candidates))
+	for _, c := range candidates {
+		isCandidate[c] = true
+	}
+	var out []models.Exclusion
+	for _, m := range team.Members {
+		if isCandidate[m.ID] {
+			continue
+		}
+		reason, ok := reasons[m.ID]
+		if !ok {
+			if m.IsActive {
+				continue
+			}
+			reason = models.ExclusionInactive
+		}
+		out = append(out, models.Exclusion{UserID: m.ID, Reason: reason})
+	}
+	return out
+}
+
+func (s *prService) explainSelection(in selectionInput, sel selection, seed int64, excluded []models.Exclusion) []models.ReviewerExplanation {
+	now := s.clock.Now()
+	out := make([]models.ReviewerExplanation, 0, len(sel.reviewers))
+	for _, id := range sel.reviewers {
+		out = append(out, models.ReviewerExplanation{
+			ReviewerID:     id,
+			Strategy:       sel.strategies[id],
+			PoolSize:       len(in.candidates),
+			Excluded:       excluded,
+			MatchedTags:    sel.matchedTags[id],
+			InWorkingHours: in.working(id),
+			Score:          in.score(id, len(sel.matchedTags[id])),
+			Seed:           seed,
+			CreatedAt:      now,
+		})
+	}
+	return out
+}
+
+// saveExplanations stores explanations in the transaction that assigns the
+// reviewers, so an assignment is never committed without them.
+func (s *prService) saveExplanations(ctx context.Context, prID string, explanations []models.ReviewerExplanation) error {
+	if len(explanations) == 0 {
+		return nil
+	}
+	if err := s.repo.SaveExplanations(ctx, prID, explanations); err != nil {
+		s.logger.Error("save explanations failed", "pr", prID, "err", err)
+		return fmt.Errorf("save explanations: %w", err)
+	}
+	return nil
+}
+
+// attachExplanations loads the explanations of the current reviewers of pr,
+// in reviewer order. Reviewers assigned before explanations were recorded
+// have none.
+func (s *prService) attachExplanations(ctx context.Context, pr *models.PullRequest) error {
+	stored, err := s.repo.GetExplanations(ctx, pr.ID)
+	if err != nil {
+		return fmt.Errorf("get explanations: %w", err)
+	}
+	pr.Explanations = nil
+	for _, id := range pr.AssignedReviewers {
+		if e, ok := stored[id]; ok {
+			pr.Explanations = append(pr.Explanations, e)
+		}
+	}
+	return nil
+}
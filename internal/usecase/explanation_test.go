@@ -0,0 +1,167 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"maps"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/repository"
+)
+
+// explanationRepo stores pull requests and explanations, rolling both back
+// when a transaction fails.
+type explanationRepo struct {
+	*reassignRepo
+	prs          map[string]models.PullRequest
+	explanations map[string]map[string]models.ReviewerExplanation
+	saveErr      error
+}
+
+func (r *explanationRepo) InTx(_ context.Context, fn func(repository.PRRepository) error) error {
+	prs := maps.Clone(r.prs)
+	explanations := make(map[string]map[string]models.ReviewerExplanation, len(r.explanations))
+	for id, stored := range r.explanations {
+		explanations[id] = maps.Clone(stored)
+	}
+	if err := fn(r); err != nil {
+		r.prs, r.explanations = prs, explanations
+		return err
+	}
+	return nil
+}
+
+func (r *explanationRepo) GetUserTeam(context.Context, string) (string, error) {
+	return "backend", nil
+}
+
+func (r *explanationRepo) GetPR(_ context.Context, prID string) (*models.PullRequest, error) {
+	pr, ok := r.prs[prID]
+	if !ok {
+		return nil, repository.ErrPRNotFound
+	}
+	pr.AssignedReviewers = slices.Clone(pr.AssignedReviewers)
+	return &pr, nil
+}
+
+func (r *explanationRepo) CreatePR(_ context.Context, pr models.PullRequest) error {
+	r.prs[pr.ID] = pr
+	return nil
+}
+
+func (r *explanationRepo) ReassignReviewer(ctx context.Context, prID, oldUserID, newUserID string) (*models.PullRequest, error) {
+	pr, err := r.GetPR(ctx, prID)
+	if err != nil {
+		return nil, err
+	}
+	pr.AssignedReviewers[slices.Index(pr.AssignedReviewers, oldUserID)] = newUserID
+	r.prs[prID] = *pr
+	return pr, nil
+}
+
+func (r *explanationRepo) SaveExplanations(_ context.Context, prID string, explanations []models.ReviewerExplanation) error {
+	if r.saveErr != nil {
+		return r.saveErr
+	}
+	if r.explanations[prID] == nil {
+		r.explanations[prID] = make(map[string]models.ReviewerExplanation)
+	}
+	for _, e := range explanations {
+		r.explanations[prID][e.ReviewerID] = e
+	}
+	return nil
+}
+
+func (r *explanationRepo) GetExplanations(_ context.Context, prID string) (map[string]models.ReviewerExplanation, error) {
+	return r.explanations[prID], nil
+}
+
+func newExplanationRepo() *explanationRepo {
+	return &explanationRepo{
+		reassignRepo: &reassignRepo{
+			owner: "platform",
+			teams: map[string][]string{
+				"backend":  {"u1"},
+				"platform": {"p1", "p2", "p3"},
+			},
+		},
+		prs:          make(map[string]models.PullRequest),
+		explanations: make(map[string]map[string]models.ReviewerExplanation),
+	}
+}
+
+func explainedReviewers(pr *models.PullRequest) []string {
+	var ids []string
+	for _, e := range pr.Explanations {
+		ids = append(ids, e.ReviewerID)
+	}
+	return ids
+}
+
+func TestExplanationsStoredWithAssignment(t *testing.T) {
+	repo := newExplanationRepo()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	s := NewPRService(repo, nil, logger, WithRand(rand.NewSource(1)))
+	ctx := context.Background()
+
+	created, err := s.CreatePR(ctx, models.PullRequest{ID: "1", Name: "Add api", AuthorID: "u1", Repository: "api"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := s.GetPR(ctx, created.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.AssignedReviewers) == 0 || !slices.Equal(explainedReviewers(got), got.AssignedReviewers) {
+		t.Fatalf("explanations after create = %v, want one per reviewer %v", explainedReviewers(got), got.AssignedReviewers)
+	}
+
+	old := got.AssignedReviewers[0]
+	_, newUserID, err := s.ReassignReviewer(ctx, created.ID, old)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err = s.GetPR(ctx, created.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(explainedReviewers(got), got.AssignedReviewers) {
+		t.Fatalf("explanations after reassign = %v, want one per reviewer %v", explainedReviewers(got), got.AssignedReviewers)
+	}
+	if e := got.Explanations[0]; e.ReviewerID != newUserID || e.Strategy != models.StrategyReplacement {
+		t.Errorf("replacement explanation = %+v, want %s picked as replacement", e, newUserID)
+	}
+}
+
+func TestExplanationFailureRollsBackAssignment(t *testing.T) {
+	repo := newExplanationRepo()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	s := NewPRService(repo, nil, logger, WithRand(rand.NewSource(1)))
+	ctx := context.Background()
+
+	created, err := s.CreatePR(ctx, models.PullRequest{ID: "1", Name: "Add api", AuthorID: "u1", Repository: "api"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo.saveErr = errors.New("disk full")
+
+	if _, err := s.CreatePR(ctx, models.PullRequest{ID: "2", Name: "Fix api", AuthorID: "u1", Repository: "api"}); !errors.Is(err, repo.saveErr) {
+		t.Errorf("create err = %v, want %v", err, repo.saveErr)
+	}
+	if _, ok := repo.prs[models.PRKey("api", "2")]; ok {
+		t.Error("pull request created without its explanations")
+	}
+
+	before := repo.prs[created.ID].AssignedReviewers
+	if _, _, err := s.ReassignReviewer(ctx, created.ID, before[0]); !errors.Is(err, repo.saveErr) {
+		t.Errorf("reassign err = %v, want %v", err, repo.saveErr)
+	}
+	if after := repo.prs[created.ID].AssignedReviewers; !slices.Equal(after, before) {
+		t.Errorf("reviewers = %v after failed reassign, want %v", after, before)
+	}
+}
@@ -3,16 +3,43 @@ package usecase
 import (
 	"context"
 
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/codeowners"
 	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
 )
 
 type PRService interface {
 	CreateTeam(ctx context.Context, team models.Team) (*models.Team, error)
 	GetTeam(ctx context.Context, teamName string) (*models.Team, error)
+	UpdateTeam(ctx context.Context, team models.Team, policy models.OpenPRPolicy) (*models.Team, error)
+	RemoveTeamMember(ctx context.Context, teamName, userID string, policy models.OpenPRPolicy) (*models.Team, error)
+	DeleteTeam(ctx context.Context, teamName string, policy models.OpenPRPolicy) ([]string, error)
+	ImportTeams(ctx context.Context, teams []models.Team, dryRun bool) (*models.TeamImport, error)
+	ExportTeams(ctx context.Context, teamName string) ([]models.Team, error)
+	GetUser(ctx context.Context, userID string) (*models.UserDetails, error)
+	ListUsers(ctx context.Context, filter models.UserFilter) ([]models.User, string, error)
 	SetUserActive(ctx context.Context, userID string, isActive bool) (*models.User, error)
+	MoveUser(ctx context.Context, userID, teamName string, policy models.MovePolicy) (*models.User, *models.ReviewHandover, error)
+	SetTeamCodeowners(ctx context.Context, teamName, content string) (*codeowners.Ruleset, error)
+	SetUserTags(ctx context.Context, userID string, tags []string) ([]string, error)
+	SetUserSchedule(ctx context.Context, userID string, schedule *models.WorkSchedule) error
+	SetUserSeniority(ctx context.Context, userID, seniority string) error
+	SetTeamMentorship(ctx context.Context, teamName string, enabled bool) error
+	SetTeamLargePRLines(ctx context.Context, teamName string, lines int) error
+	SetRepository(ctx context.Context, repository models.Repository) (*models.Repository, error)
+	ListRepositories(ctx context.Context) ([]models.Repository, error)
+	SetPairRules(ctx context.Context, teamName string, rules []models.PairRule) ([]models.PairRule, error)
+	GetPairRules(ctx context.Context, teamName string) ([]models.PairRule, error)
+	SetTeamSLA(ctx context.Context, sla models.TeamSLA) (*models.TeamSLA, error)
+	GetTeamSLA(ctx context.Context, teamName string) (*models.TeamSLA, error)
 
 	CreatePR(ctx context.Context, pr models.PullRequest) (*models.PullRequest, error)
+	GetPR(ctx context.Context, prID string) (*models.PullRequest, error)
+	ListPRs(ctx context.Context, filter models.PRFilter) ([]models.PullRequest, *models.Cursor, error)
+	ReadyPR(ctx context.Context, prID string) (*models.PullRequest, error)
 	MergePR(ctx context.Context, prID string) (*models.PullRequest, error)
 	ReassignReviewer(ctx context.Context, prID, oldUserID string) (*models.PullRequest, string, error)
-	GetUserReviews(ctx context.Context, userID string) ([]models.PRShort, error)
+	GetUserReviews(ctx context.Context, userID string, filter models.ReviewFilter) ([]models.PRShort, *models.Cursor, error)
+	ListOverdue(ctx context.Context, filter models.OverdueFilter) ([]models.OverdueReview, error)
+	ProcessOverdue(ctx context.Context) (*models.SLARun, error)
+	GetFairness(ctx context.Context, filter models.FairnessFilter) (*models.FairnessReport, error)
 }
@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+)
+
+func (s *prService) SetUserSeniority(ctx context.Context, userID, seniority string) error {
+	if userID == "" {
+		s.logger.Warn("invalid user id")
+		return invalidArgument("user id required")
+	}
+	switch seniority {
+	case models.SeniorityJunior, models.SeniorityMiddle, models.SenioritySenior:
+	default:
+		return invalidArgument("unknown seniority %q", seniority)
+	}
+	if err := s.repo.SetUserSeniority(ctx, userID, seniority); err != nil {
+		s.logger.Error("set seniority failed", "err", err)
+		return fmt.Errorf("set seniority: %w", err)
+	}
+	return nil
+}
+
+func (s *prService) SetTeamMentorship(ctx context.Context, teamName string, enabled bool) error {
+	if teamName == "" {
+		s.logger.Warn("invalid team name")
+		return invalidArgument("team name required")
+	}
+	if err := s.repo.SetTeamMentorship(ctx, teamName, enabled); err != nil {
+		s.logger.Error("set mentorship failed", "err", err)
+		return fmt.Errorf("set mentorship: %w", err)
+	}
+	return nil
+}
+
+// teamSeniority returns seniority of the users when the team runs
+// mentorship and nil otherwise.
+func (s *prService) teamSeniority(ctx context.Context, team *models.Team, userIDs []string) (map[string]string, error) {
+	if team == nil || !team.Mentorship || len(userIDs) == 0 {
+		return nil, nil
+	}
+	levels, err := s.repo.GetUsersSeniority(ctx, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("get seniority: %w", err)
+	}
+	return levels, nil
+}
+
+// seniorReplacement narrows the candidates replacing reviewerID on pr to
+// seniors when the team runs mentorship and no other reviewer is senior. The
+// second result is true if the candidates were narrowed; without a senior
+// candidate they are kept and a policy violation is returned.
+func (s *prService) seniorReplacement(ctx context.Context, team *models.Team, pr *models.PullRequest, reviewerID string, candidates []string) ([]string, bool, []string, error) {
+	levels, err := s.teamSeniority(ctx, team, append(slices.Clone(pr.AssignedReviewers), candidates...))
+	if err != nil || levels == nil {
+		return candidates, false, nil, err
+	}
+	for _, r := range pr.AssignedReviewers {
+		if r != reviewerID && levels[r] == models.SenioritySenior {
+			return candidates, false, nil, nil
+		}
+	}
+	var seniors []string
+	for _, c := range candidates {
+		if levels[c] == models.SenioritySenior {
+			seniors = append(seniors, c)
+		}
+	}
+	if len(seniors) == 0 {
+		s.logger.Warn("no senior reviewer available", "pr", pr.ID, "team", team.Name)
+		return candidates, false, []string{models.ViolationNoSenior}, nil
+	}
+	return seniors, true, nil, nil
+}
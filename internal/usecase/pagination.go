@@ -0,0 +1,6 @@
+package usecase
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+)
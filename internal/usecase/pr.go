@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+)
+
+func (s *prService) GetPR(ctx context.Context, prID string) (*models.PullRequest, error) {
+	if prID == "" {
+		s.logger.Warn("invalid pr id")
+		return nil, invalidArgument("pr id required")
+	}
+	pr, err := s.repo.GetPR(ctx, prID)
+	if err != nil {
+		s.logger.Error("get pr failed", "err", err)
+		return nil, fmt.Errorf("get pr: %w", err)
+	}
+	if err := s.attachExplanations(ctx, pr); err != nil {
+		s.logger.Error("get explanations failed", "err", err)
+		return nil, err
+	}
+	return pr, nil
+}
+
+func (s *prService) ListPRs(ctx context.Context, filter models.PRFilter) ([]models.PullRequest, *models.Cursor, error) {
+	if filter.Status != "" && filter.Status != "OPEN" && filter.Status != "MERGED" {
+		return nil, nil, fmt.Errorf("%w: unknown status %q", ErrInvalidFilter, filter.Status)
+	}
+	if filter.Limit < 0 || filter.Limit > maxPageSize {
+		return nil, nil, fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidFilter, maxPageSize)
+	}
+	if filter.Limit == 0 {
+		filter.Limit = defaultPageSize
+	}
+
+	limit := filter.Limit
+	filter.Limit++
+	prs, err := s.repo.ListPRs(ctx, filter)
+	if err != nil {
+		s.logger.Error("list prs failed", "err", err)
+		return nil, nil, fmt.Errorf("list prs: %w", err)
+	}
+
+	var next *models.Cursor
+	if len(prs) > limit {
+		prs = prs[:limit]
+		last := prs[limit-1]
+		next = &models.Cursor{CreatedAt: *last.CreatedAt, ID: last.ID}
+	}
+	return prs, next, nil
+}
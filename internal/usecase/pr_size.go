@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+)
+
+// SetTeamLargePRLines makes pull requests of the team changing at least lines
+// lines get an extra reviewer; zero turns this off.
+func (s *prService) SetTeamLargePRLines(ctx context.Context, teamName string, lines int) error {
+	if teamName == "" {
+		s.logger.Warn("invalid team name")
+		return invalidArgument("team name required")
+	}
+	if lines < 0 {
+		return invalidArgument("line count must not be negative")
+	}
+	if err := s.repo.SetTeamLargePRLines(ctx, teamName, lines); err != nil {
+		s.logger.Error("set large pr lines failed", "err", err)
+		return fmt.Errorf("set large pr lines: %w", err)
+	}
+	return nil
+}
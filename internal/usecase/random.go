@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// seedSource hands out one seed per reviewer selection. Every selection runs
+// on its own generator built from that seed and logs it, so that given the
+// same candidates the choice can be replayed exactly.
+type seedSource struct {
+	mu  sync.Mutex
+	src rand.Source
+	// fixed, when set, is handed out for every selection instead.
+	fixed *int64
+}
+
+func (s *seedSource) next() int64 {
+	if s.fixed != nil {
+		return *s.fixed
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.src.Int63()
+}
+
+// WithRand replaces the time-seeded source that seeds reviewer selection.
+func WithRand(src rand.Source) Option {
+	return func(s *prService) {
+		s.seeds = &seedSource{src: src}
+	}
+}
+
+// WithReplaySeed runs every selection on seed, the value logged for one
+// earlier selection, so that selection can be replayed against the same
+// candidates.
+func WithReplaySeed(seed int64) Option {
+	return func(s *prService) {
+		s.seeds = &seedSource{fixed: &seed}
+	}
+}
+
+func defaultSeeds() *seedSource {
+	return &seedSource{src: rand.NewSource(time.Now().UnixNano())}
+}
+
+// newRand returns a generator for a single selection together with its seed.
+func (s *prService) newRand() (*rand.Rand, int64) {
+	seed := s.seeds.next()
+	return rand.New(rand.NewSource(seed)), seed
+}
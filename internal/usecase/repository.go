@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+)
+
+// SetRepository registers a repository or changes the team reviewing its
+// pull requests; an empty team leaves them to the author's team.
+func (s *prService) SetRepository(ctx context.Context, repository models.Repository) (*models.Repository, error) {
+	if repository.Name == "" {
+		s.logger.Warn("invalid repository name")
+		return nil, invalidArgument("repository name required")
+	}
+	if err := s.repo.SetRepository(ctx, repository); err != nil {
+		s.logger.Error("set repository failed", "err", err)
+		return nil, fmt.Errorf("set repository: %w", err)
+	}
+	return &repository, nil
+}
+
+func (s *prService) ListRepositories(ctx context.Context) ([]models.Repository, error) {
+	repos, err := s.repo.ListRepositories(ctx)
+	if err != nil {
+		s.logger.Error("list repositories failed", "err", err)
+		return nil, fmt.Errorf("list repositories: %w", err)
+	}
+	return repos, nil
+}
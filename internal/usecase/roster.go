@@ -0,0 +1,195 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+)
+
+// maxImportUsers bounds the size of a single import document.
+const maxImportUsers = 10000
+
+// ImportTeams syncs the listed teams to the given membership. Listed users
+// are created, updated or moved into their team; active members of listed
+// teams missing from the document are deactivated. Teams not in the document
+// are left alone. Open reviews of moved users are handed over within their
+// old team, those of deactivated users within the reviewing team, in the same
+// transaction as the import. With dryRun the changes are only computed.
+func (s *prService) ImportTeams(ctx context.Context, teams []models.Team, dryRun bool) (*models.TeamImport, error) {
+	if len(teams) == 0 {
+		s.logger.Warn("empty team import")
+		return nil, invalidArgument("no teams to import")
+	}
+
+	names := make([]string, 0, len(teams))
+	listed := make(map[string]bool)
+	var ids []string
+	for _, team := range teams {
+		if err := s.validateTeam(team); err != nil {
+			return nil, err
+		}
+		for _, m := range team.Members {
+			if !models.ValidID(m.ID) {
+				s.logger.Warn("invalid user id in import", "user", m.ID)
+				return nil, invalidArgument("user id %q may contain only letters, digits and . _ : -", m.ID)
+			}
+			if listed[m.ID] {
+				s.logger.Warn("user listed twice in import", "user", m.ID)
+				return nil, invalidArgument("user %s listed more than once", m.ID)
+			}
+			listed[m.ID] = true
+			ids = append(ids, m.ID)
+		}
+		names = append(names, team.Name)
+	}
+	if len(ids) > maxImportUsers {
+		return nil, invalidArgument("at most %d users per import", maxImportUsers)
+	}
+
+	var (
+		res    *models.TeamImport
+		events []models.Event
+	)
+	err := s.inTx(ctx, func(tx *prService) error {
+		if !dryRun {
+			if err := tx.repo.LockRoster(ctx, names, ids); err != nil {
+				s.logger.Error("lock roster failed", "err", err)
+				return fmt.Errorf("lock roster: %w", err)
+			}
+		}
+		var (
+			deactivate []string
+			err        error
+		)
+		if res, deactivate, err = tx.importDiff(ctx, teams, names, ids, listed); err != nil {
+			return err
+		}
+
+		changed := len(res.CreatedTeams)+len(res.Added)+len(res.Updated)+len(res.Moved)+len(res.Deactivated) > 0
+		if dryRun || !changed {
+			return nil
+		}
+
+		if err := tx.repo.ImportTeams(ctx, teams, deactivate); err != nil {
+			s.logger.Error("import teams failed", "err", err)
+			return fmt.Errorf("import teams: %w", err)
+		}
+		// Handovers run on the imported state, so nobody moved away or
+		// deactivated by this document is picked as a replacement.
+		for _, m := range res.Moved {
+			if m.FromTeam == "" {
+				continue
+			}
+			_, moved, err := tx.handOverMoved(ctx, m.ID, m.FromTeam)
+			if err != nil {
+				return err
+			}
+			events = append(events, moved...)
+		}
+		if len(res.Deactivated) > 0 {
+			inactive := make([]string, 0, len(res.Deactivated))
+			for _, u := range res.Deactivated {
+				inactive = append(inactive, u.ID)
+			}
+			prs, err := tx.repo.GetOpenPRsByUsers(ctx, inactive)
+			if err != nil {
+				s.logger.Error("get open prs failed", "err", err)
+				return fmt.Errorf("get open prs: %w", err)
+			}
+			handed, err := tx.handOverLeaving(ctx, prs, inactive)
+			if err != nil {
+				return err
+			}
+			events = append(events, handed...)
+		}
+		res.Applied = true
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.publish(ctx, events...)
+	if res.Applied {
+		s.logger.Info("teams imported", "teams", len(teams), "added", len(res.Added), "updated", len(res.Updated),
+			"moved", len(res.Moved), "deactivated", len(res.Deactivated))
+	}
+	return res, nil
+}
+
+// importDiff compares the document with the stored teams and users, returning
+// the changes and the unlisted active members of listed teams to deactivate.
+func (s *prService) importDiff(ctx context.Context, teams []models.Team, names, ids []string, listed map[string]bool) (*models.TeamImport, []string, error) {
+	current, err := s.repo.ListTeams(ctx, names)
+	if err != nil {
+		s.logger.Error("list teams failed", "err", err)
+		return nil, nil, fmt.Errorf("list teams: %w", err)
+	}
+	users, err := s.repo.GetUsers(ctx, ids)
+	if err != nil {
+		s.logger.Error("get users failed", "err", err)
+		return nil, nil, fmt.Errorf("get users: %w", err)
+	}
+
+	exists := make(map[string]bool, len(current))
+	for _, t := range current {
+		exists[t.Name] = true
+	}
+	known := make(map[string]models.User, len(users))
+	for _, u := range users {
+		known[u.ID] = u
+	}
+
+	res := &models.TeamImport{}
+	for _, team := range teams {
+		if !exists[team.Name] {
+			res.CreatedTeams = append(res.CreatedTeams, team.Name)
+		}
+		for _, m := range team.Members {
+			next := models.User{ID: m.ID, Username: m.Username, TeamName: team.Name, IsActive: m.IsActive}
+			prev, ok := known[m.ID]
+			switch {
+			case !ok:
+				res.Added = append(res.Added, next)
+			case prev.TeamName != team.Name:
+				res.Moved = append(res.Moved, models.UserMove{User: next, FromTeam: prev.TeamName})
+			case prev.IsActive && !next.IsActive:
+				res.Deactivated = append(res.Deactivated, next)
+			case prev != next:
+				res.Updated = append(res.Updated, next)
+			default:
+				res.Unchanged++
+			}
+		}
+	}
+
+	var deactivate []string
+	for _, t := range current {
+		for _, m := range t.Members {
+			if listed[m.ID] || !m.IsActive {
+				continue
+			}
+			deactivate = append(deactivate, m.ID)
+			res.Deactivated = append(res.Deactivated, models.User{ID: m.ID, Username: m.Username, TeamName: t.Name})
+		}
+	}
+	return res, deactivate, nil
+}
+
+// ExportTeams returns one team, or all teams when teamName is empty.
+func (s *prService) ExportTeams(ctx context.Context, teamName string) ([]models.Team, error) {
+	if teamName != "" {
+		team, err := s.repo.GetTeam(ctx, teamName)
+		if err != nil {
+			s.logger.Error("get team failed", "err", err)
+			return nil, fmt.Errorf("get team: %w", err)
+		}
+		return []models.Team{*team}, nil
+	}
+	teams, err := s.repo.ListTeams(ctx, nil)
+	if err != nil {
+		s.logger.Error("list teams failed", "err", err)
+		return nil, fmt.Errorf("list teams: %w", err)
+	}
+	return teams, nil
+}
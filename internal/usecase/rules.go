@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+)
+
+// SetPairRules replaces the pair rules of a team. Both users of a rule must
+// be team members, a pair may have one rule only, and no author may have
+// more "always" reviewers than a pull request gets.
+func (s *prService) SetPairRules(ctx context.Context, teamName string, rules []models.PairRule) ([]models.PairRule, error) {
+	if teamName == "" {
+		s.logger.Warn("invalid team name")
+		return nil, invalidArgument("team name required")
+	}
+
+	type pair struct{ reviewer, author string }
+	seen := make(map[pair]bool, len(rules))
+	always := make(map[string]int)
+	for _, r := range rules {
+		if r.Kind != models.PairNever && r.Kind != models.PairAlways {
+			return nil, invalidArgument("unknown rule type %q", r.Kind)
+		}
+		if r.ReviewerID == "" || r.AuthorID == "" {
+			return nil, invalidArgument("reviewer and author required")
+		}
+		if r.ReviewerID == r.AuthorID {
+			return nil, invalidArgument("%s cannot be paired with themselves", r.ReviewerID)
+		}
+		p := pair{r.ReviewerID, r.AuthorID}
+		if seen[p] {
+			return nil, invalidArgument("more than one rule for reviewer %s and author %s", r.ReviewerID, r.AuthorID)
+		}
+		seen[p] = true
+		if r.Kind == models.PairAlways {
+			always[r.AuthorID]++
+			if always[r.AuthorID] > maxReviewers {
+				return nil, invalidArgument("author %s has more than %d required reviewers", r.AuthorID, maxReviewers)
+			}
+		}
+	}
+
+	team, err := s.repo.GetTeam(ctx, teamName)
+	if err != nil {
+		s.logger.Error("get team failed", "err", err)
+		return nil, fmt.Errorf("get team: %w", err)
+	}
+	members := make(map[string]bool, len(team.Members))
+	for _, m := range team.Members {
+		members[m.ID] = true
+	}
+	for _, r := range rules {
+		for _, id := range []string{r.ReviewerID, r.AuthorID} {
+			if !members[id] {
+				return nil, &NotTeamMemberError{UserID: id}
+			}
+		}
+	}
+
+	if err := s.repo.SetPairRules(ctx, teamName, rules); err != nil {
+		s.logger.Error("set pair rules failed", "err", err)
+		return nil, fmt.Errorf("set pair rules: %w", err)
+	}
+	return s.GetPairRules(ctx, teamName)
+}
+
+func (s *prService) GetPairRules(ctx context.Context, teamName string) ([]models.PairRule, error) {
+	if teamName == "" {
+		s.logger.Warn("invalid team name")
+		return nil, invalidArgument("team name required")
+	}
+	rules, err := s.repo.GetPairRules(ctx, teamName)
+	if err != nil {
+		s.logger.Error("get pair rules failed", "err", err)
+		return nil, fmt.Errorf("get pair rules: %w", err)
+	}
+	return rules, nil
+}
+
+// pairConstraints are the pair rules that apply to one author.
+type pairConstraints struct {
+	never  map[string]bool
+	always []string
+}
+
+// pairConstraints loads the rules the reviewing team set for the author. Rules
+// of the author's own team do not follow pull requests reviewed elsewhere.
+func (s *prService) pairConstraints(ctx context.Context, teamName, authorID string) (pairConstraints, error) {
+	rules, err := s.repo.GetAuthorPairRules(ctx, teamName, authorID)
+	if err != nil {
+		return pairConstraints{}, fmt.Errorf("get pair rules: %w", err)
+	}
+	c := pairConstraints{never: make(map[string]bool)}
+	for _, r := range rules {
+		switch r.Kind {
+		case models.PairNever:
+			c.never[r.ReviewerID] = true
+		case models.PairAlways:
+			c.always = append(c.always, r.ReviewerID)
+		}
+	}
+	return c, nil
+}
+
+// filter drops the candidates the author must never get and records why in
+// reasons.
+func (c pairConstraints) filter(candidates []string, reasons map[string]string) []string {
+	out := make([]string, 0, len(candidates))
+	for _, id := range candidates {
+		if c.never[id] {
+			reasons[id] = models.ExclusionPairRule
+			continue
+		}
+		out = append(out, id)
+	}
+	return out
+}
+
+func (c pairConstraints) requires(id string) bool {
+	for _, a := range c.always {
+		if a == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+)
+
+// SetUserSchedule stores a user's working hours; nil removes them, making the
+// user always available.
+func (s *prService) SetUserSchedule(ctx context.Context, userID string, schedule *models.WorkSchedule) error {
+	if userID == "" {
+		s.logger.Warn("invalid user id")
+		return invalidArgument("user id required")
+	}
+	if schedule != nil {
+		if schedule.Location == nil {
+			return invalidArgument("timezone required")
+		}
+		if schedule.Start < 0 || schedule.End > 24*60 || schedule.Start >= schedule.End {
+			return invalidArgument("working hours must start before they end within one day")
+		}
+		if schedule.Days == [7]bool{} {
+			return invalidArgument("at least one working day required")
+		}
+	}
+
+	if err := s.repo.SetUserSchedule(ctx, userID, schedule); err != nil {
+		s.logger.Error("set schedule failed", "err", err)
+		return fmt.Errorf("set schedule: %w", err)
+	}
+	return nil
+}
+
+// workingNow reports which of the users are within working hours right now.
+func (s *prService) workingNow(ctx context.Context, userIDs []string) (map[string]bool, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	schedules, err := s.repo.GetUserSchedules(ctx, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("get schedules: %w", err)
+	}
+	now := s.clock.Now()
+	working := make(map[string]bool, len(userIDs))
+	for _, id := range userIDs {
+		working[id] = schedules[id].Contains(now)
+	}
+	return working, nil
+}
+
+// workingIDs lists the users marked as within working hours, for logging.
+func workingIDs(working map[string]bool) []string {
+	ids := make([]string, 0, len(working))
+	for id, ok := range working {
+		if ok {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// pickReviewer returns a random candidate, preferring those within working
+// hours, and the explanation of the pick without exclusions. Candidates must
+// come in a stable order for the logged seed to reproduce the pick.
+func (s *prService) pickReviewer(ctx context.Context, prID string, candidates []string) (string, models.ReviewerExplanation, error) {
+	working, err := s.workingNow(ctx, candidates)
+	if err != nil {
+		return "", models.ReviewerExplanation{}, err
+	}
+	var preferred []string
+	for _, c := range candidates {
+		if working[c] {
+			preferred = append(preferred, c)
+		}
+	}
+	if len(preferred) == 0 {
+		preferred = candidates
+	}
+	rng, seed := s.newRand()
+	picked := preferred[rng.Intn(len(preferred))]
+	s.logger.Info("reviewer picked", "pr", prID, "seed", seed, "candidates", preferred, "reviewer", picked)
+
+	in := selectionInput{candidates: candidates, inHours: working}
+	return picked, models.ReviewerExplanation{
+		ReviewerID:     picked,
+		Strategy:       models.StrategyReplacement,
+		PoolSize:       len(candidates),
+		InWorkingHours: in.working(picked),
+		Score:          in.score(picked, 0),
+		Seed:           seed,
+		CreatedAt:      s.clock.Now(),
+	}, nil
+}
@@ -0,0 +1,221 @@
+package usecase
+
+import (
+	"math/rand"
+	"slices"
+	"sort"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+)
+
+const maxReviewers = 2
+
+// largePRReviewers is the reviewer count of pull requests at or above the
+// team's large PR size.
+const largePRReviewers = maxReviewers + 1
+
+type selectionInput struct {
+	candidates   []string
+	owners       []string
+	candidateTag map[string][]string
+	requiredTags []string
+	// required are picked before anyone else; they must be candidates.
+	required []string
+	// inHours marks candidates currently within working hours; nil means
+	// everyone is.
+	inHours map[string]bool
+	// mentorship asks for a senior and then a junior reviewer, using
+	// seniority by candidate id.
+	mentorship bool
+	seniority  map[string]string
+	// limit is the number of reviewers to pick; zero means maxReviewers.
+	limit int
+}
+
+func (in selectionInput) working(id string) bool {
+	return in.inHours == nil || in.inHours[id]
+}
+
+type selection struct {
+	reviewers     []string
+	strategies    map[string]string
+	matchedTags   map[string][]string
+	uncoveredTags []string
+	violations    []string
+}
+
+// score ranks a candidate the way selectReviewers prefers them; see
+// models.ReviewerExplanation.
+func (in selectionInput) score(id string, matched int) int {
+	score := 100 * matched
+	for _, o := range in.owners {
+		if o == id {
+			score += 10
+			break
+		}
+	}
+	if in.working(id) {
+		score++
+	}
+	return score
+}
+
+// selectReviewers picks up to in.limit reviewers from candidates. Required
+// reviewers go first. In a mentorship team a senior comes next and, if a
+// slot is left, a junior; among each level the candidate covering most
+// required tags wins, then the usual ties. Required tags are covered next, greedily taking the
+// candidate that covers the most tags still uncovered (people in working
+// hours, then code owners win ties). Remaining
+// slots go to code owners in their priority order and then to random
+// candidates, first among those in working hours and then among the rest.
+// All randomness comes from rng, so a seeded rng gives a repeatable result.
+func selectReviewers(rng *rand.Rand, in selectionInput) selection {
+	limit := in.limit
+	if limit == 0 {
+		limit = maxReviewers
+	}
+	pool := make([]string, len(in.candidates))
+	copy(pool, in.candidates)
+	sort.Strings(pool)
+	rng.Shuffle(len(pool), func(i, j int) { pool[i], pool[j] = pool[j], pool[i] })
+
+	ownerRank := make(map[string]int, len(in.owners))
+	for i, o := range in.owners {
+		if _, ok := ownerRank[o]; !ok {
+			ownerRank[o] = i
+		}
+	}
+	better := func(a, b string) bool {
+		if wa, wb := in.working(a), in.working(b); wa != wb {
+			return wa
+		}
+		ra, okA := ownerRank[a]
+		rb, okB := ownerRank[b]
+		if okA != okB {
+			return okA
+		}
+		return okA && ra < rb
+	}
+
+	sel := selection{reviewers: make([]string, 0, limit), strategies: make(map[string]string, limit)}
+	chosen := make(map[string]bool, limit)
+	pick := func(id, strategy string) {
+		sel.reviewers = append(sel.reviewers, id)
+		sel.strategies[id] = strategy
+		chosen[id] = true
+	}
+
+	uncovered := make(map[string]bool, len(in.requiredTags))
+	for _, t := range in.requiredTags {
+		uncovered[t] = true
+	}
+	claim := func(id string) {
+		for _, t := range in.candidateTag[id] {
+			if uncovered[t] {
+				if sel.matchedTags == nil {
+					sel.matchedTags = make(map[string][]string)
+				}
+				sel.matchedTags[id] = append(sel.matchedTags[id], t)
+				delete(uncovered, t)
+			}
+		}
+	}
+
+	for _, id := range in.required {
+		if len(sel.reviewers) < limit && !chosen[id] {
+			pick(id, models.StrategyPairRule)
+			claim(id)
+		}
+	}
+	if in.mentorship {
+		level := func(want string) func(string) bool {
+			return func(id string) bool { return in.seniority[id] == want }
+		}
+		bestOf := func(ok func(string) bool) string {
+			best, bestCover := "", -1
+			for _, c := range pool {
+				if chosen[c] || !ok(c) {
+					continue
+				}
+				cover := 0
+				for _, t := range in.candidateTag[c] {
+					if uncovered[t] {
+						cover++
+					}
+				}
+				if cover > bestCover || (cover == bestCover && better(c, best)) {
+					best, bestCover = c, cover
+				}
+			}
+			return best
+		}
+		if !slices.ContainsFunc(sel.reviewers, level(models.SenioritySenior)) {
+			if id := bestOf(level(models.SenioritySenior)); id != "" && len(sel.reviewers) < limit {
+				claim(id)
+				pick(id, models.StrategySenior)
+			} else {
+				sel.violations = append(sel.violations, models.ViolationNoSenior)
+			}
+		}
+		if !slices.ContainsFunc(sel.reviewers, level(models.SeniorityJunior)) && len(sel.reviewers) < limit {
+			if id := bestOf(level(models.SeniorityJunior)); id != "" {
+				claim(id)
+				pick(id, models.StrategyJunior)
+			}
+		}
+	}
+	for len(uncovered) > 0 && len(sel.reviewers) < limit {
+		best, bestCover := "", 0
+		for _, c := range pool {
+			if chosen[c] {
+				continue
+			}
+			cover := 0
+			for _, t := range in.candidateTag[c] {
+				if uncovered[t] {
+					cover++
+				}
+			}
+			if cover > bestCover || (cover == bestCover && cover > 0 && better(c, best)) {
+				best, bestCover = c, cover
+			}
+		}
+		if bestCover == 0 {
+			break
+		}
+		claim(best)
+		pick(best, models.StrategyRequiredTags)
+	}
+	for _, t := range in.requiredTags {
+		if uncovered[t] {
+			sel.uncoveredTags = append(sel.uncoveredTags, t)
+			delete(uncovered, t)
+		}
+	}
+
+	inPool := make(map[string]bool, len(pool))
+	for _, c := range pool {
+		inPool[c] = true
+	}
+	fill := func(workingOnly bool) {
+		for _, o := range in.owners {
+			if len(sel.reviewers) == limit {
+				return
+			}
+			if inPool[o] && !chosen[o] && (!workingOnly || in.working(o)) {
+				pick(o, models.StrategyCodeOwner)
+			}
+		}
+		for _, c := range pool {
+			if len(sel.reviewers) == limit {
+				return
+			}
+			if !chosen[c] && (!workingOnly || in.working(c)) {
+				pick(c, models.StrategyRandom)
+			}
+		}
+	}
+	fill(true)
+	fill(false)
+	return sel
+}
@@ -0,0 +1,388 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/clock"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/repository"
+)
+
+func seeded(seed int64) *rand.Rand {
+	return rand.New(rand.NewSource(seed))
+}
+
+func TestSelectReviewersSeeded(t *testing.T) {
+	candidates := []string{"u1", "u2", "u3", "u4", "u5"}
+	tests := []struct {
+		seed int64
+		in   selectionInput
+		want []string
+	}{
+		{1, selectionInput{candidates: candidates}, []string{"u3", "u1"}},
+		{42, selectionInput{candidates: candidates}, []string{"u3", "u4"}},
+		{7, selectionInput{candidates: candidates}, []string{"u3", "u2"}},
+		// The pool is sorted before shuffling, so the input order does not
+		// change the outcome for a seed.
+		{42, selectionInput{candidates: []string{"u5", "u3", "u1", "u4", "u2"}}, []string{"u3", "u4"}},
+		// Only u2, u4 and u5 are in working hours and fill all three slots.
+		{42, selectionInput{
+			candidates: candidates,
+			inHours:    map[string]bool{"u2": true, "u4": true, "u5": true},
+			limit:      3,
+		}, []string{"u4", "u5", "u2"}},
+	}
+	for _, tt := range tests {
+		sel := selectReviewers(seeded(tt.seed), tt.in)
+		if !slices.Equal(sel.reviewers, tt.want) {
+			t.Errorf("seed %d: reviewers = %v, want %v", tt.seed, sel.reviewers, tt.want)
+		}
+		for _, r := range sel.reviewers {
+			if sel.strategies[r] != models.StrategyRandom {
+				t.Errorf("seed %d: strategy of %s = %q, want random", tt.seed, r, sel.strategies[r])
+			}
+		}
+	}
+}
+
+func TestSelectReviewersPriorities(t *testing.T) {
+	candidates := []string{"u1", "u2", "u3", "u4", "u5"}
+	tests := []struct {
+		name           string
+		in             selectionInput
+		want           []string
+		wantStrategies map[string]string
+		wantUncovered  []string
+		wantViolations []string
+	}{
+		{
+			name: "required reviewer first, then code owners in order",
+			in:   selectionInput{candidates: candidates, required: []string{"u4"}, owners: []string{"u2", "u1"}},
+			want: []string{"u4", "u2"},
+			wantStrategies: map[string]string{
+				"u4": models.StrategyPairRule,
+				"u2": models.StrategyCodeOwner,
+			},
+		},
+		{
+			name: "owners out of working hours yield to random working candidates",
+			in: selectionInput{
+				candidates: candidates,
+				owners:     []string{"u1", "u2"},
+				inHours:    map[string]bool{"u2": true, "u5": true},
+			},
+			want: []string{"u2", "u5"},
+			wantStrategies: map[string]string{
+				"u2": models.StrategyCodeOwner,
+				"u5": models.StrategyRandom,
+			},
+		},
+		{
+			name: "tags are covered greedily",
+			in: selectionInput{
+				candidates:   candidates,
+				requiredTags: []string{"go", "sql", "k8s"},
+				candidateTag: map[string][]string{
+					"u1": {"go"},
+					"u3": {"go", "sql"},
+					"u5": {"k8s"},
+				},
+			},
+			want: []string{"u3", "u5"},
+			wantStrategies: map[string]string{
+				"u3": models.StrategyRequiredTags,
+				"u5": models.StrategyRequiredTags,
+			},
+		},
+		{
+			name: "tags nobody has stay uncovered",
+			in: selectionInput{
+				candidates:   []string{"u1", "u2"},
+				requiredTags: []string{"rust", "go"},
+				candidateTag: map[string][]string{"u2": {"go"}},
+				limit:        1,
+			},
+			want:           []string{"u2"},
+			wantStrategies: map[string]string{"u2": models.StrategyRequiredTags},
+			wantUncovered:  []string{"rust"},
+		},
+		{
+			name: "mentorship picks a senior and a junior",
+			in: selectionInput{
+				candidates: candidates,
+				mentorship: true,
+				seniority:  map[string]string{"u1": models.SeniorityJunior, "u4": models.SenioritySenior},
+			},
+			want: []string{"u4", "u1"},
+			wantStrategies: map[string]string{
+				"u4": models.StrategySenior,
+				"u1": models.StrategyJunior,
+			},
+		},
+		{
+			name: "mentorship without seniors is a violation",
+			in: selectionInput{
+				candidates: []string{"u1"},
+				mentorship: true,
+				seniority:  map[string]string{"u1": models.SeniorityJunior},
+			},
+			want:           []string{"u1"},
+			wantStrategies: map[string]string{"u1": models.StrategyJunior},
+			wantViolations: []string{models.ViolationNoSenior},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// None of these outcomes may depend on the seed.
+			for _, seed := range []int64{1, 2, 3} {
+				sel := selectReviewers(seeded(seed), tt.in)
+				if !slices.Equal(sel.reviewers, tt.want) {
+					t.Fatalf("seed %d: reviewers = %v, want %v", seed, sel.reviewers, tt.want)
+				}
+				if !reflect.DeepEqual(sel.strategies, tt.wantStrategies) {
+					t.Errorf("seed %d: strategies = %v, want %v", seed, sel.strategies, tt.wantStrategies)
+				}
+				if !slices.Equal(sel.uncoveredTags, tt.wantUncovered) {
+					t.Errorf("seed %d: uncovered = %v, want %v", seed, sel.uncoveredTags, tt.wantUncovered)
+				}
+				if !slices.Equal(sel.violations, tt.wantViolations) {
+					t.Errorf("seed %d: violations = %v, want %v", seed, sel.violations, tt.wantViolations)
+				}
+			}
+		})
+	}
+}
+
+// scheduleRepo serves working hours to pickReviewer; other repository calls
+// panic on the nil embedded interface.
+type scheduleRepo struct {
+	repository.PRRepository
+	schedules map[string]*models.WorkSchedule
+}
+
+func (r *scheduleRepo) GetUserSchedules(context.Context, []string) (map[string]*models.WorkSchedule, error) {
+	return r.schedules, nil
+}
+
+func newTestService(t *testing.T, opts ...Option) *prService {
+	t.Helper()
+	moscow, err := time.LoadLocation("Europe/Moscow")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// u2 works Monday to Friday from 9 to 18 in Moscow; the others have no
+	// schedule and are always available.
+	office := &models.WorkSchedule{Location: moscow, Start: 9 * 60, End: 18 * 60}
+	for d := time.Monday; d <= time.Friday; d++ {
+		office.Days[d] = true
+	}
+	repo := &scheduleRepo{schedules: map[string]*models.WorkSchedule{"u2": office}}
+	// Saturday noon in Moscow: u2 is off.
+	c := clock.NewFake(time.Date(2025, 3, 8, 12, 0, 0, 0, moscow))
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	return NewPRService(repo, nil, logger, append([]Option{WithClock(c)}, opts...)...).(*prService)
+}
+
+func TestPickReviewerSeeded(t *testing.T) {
+	s := newTestService(t, WithRand(rand.NewSource(42)))
+	candidates := []string{"u1", "u2", "u3"}
+
+	var got []string
+	for i := 0; i < 3; i++ {
+		id, e, err := s.pickReviewer(context.Background(), "pr-1", candidates)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if e.ReviewerID != id || e.PoolSize != 3 || !e.InWorkingHours || e.Strategy != models.StrategyReplacement {
+			t.Errorf("explanation = %+v", e)
+		}
+		got = append(got, id)
+	}
+	// u2 is out of working hours, so only u1 and u3 can be picked.
+	if want := []string{"u3", "u3", "u1"}; !slices.Equal(got, want) {
+		t.Errorf("picked %v, want %v", got, want)
+	}
+}
+
+func TestPickReviewerOutOfHoursFallback(t *testing.T) {
+	s := newTestService(t, WithRand(rand.NewSource(42)))
+	id, e, err := s.pickReviewer(context.Background(), "pr-1", []string{"u2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != "u2" || e.InWorkingHours {
+		t.Errorf("picked %s in hours %v, want u2 out of hours", id, e.InWorkingHours)
+	}
+}
+
+func TestPickReviewerReplay(t *testing.T) {
+	candidates := []string{"u1", "u3", "u4", "u5", "u6"}
+	s := newTestService(t, WithRand(rand.NewSource(7)))
+	var picks []string
+	var seeds []int64
+	for i := 0; i < 5; i++ {
+		id, e, err := s.pickReviewer(context.Background(), "pr-1", candidates)
+		if err != nil {
+			t.Fatal(err)
+		}
+		picks = append(picks, id)
+		seeds = append(seeds, e.Seed)
+	}
+
+	// Each logged seed replays its own selection, every time.
+	for i, seed := range seeds {
+		replay := newTestService(t, WithReplaySeed(seed))
+		for j := 0; j < 2; j++ {
+			id, e, err := replay.pickReviewer(context.Background(), "pr-1", candidates)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if id != picks[i] || e.Seed != seed {
+				t.Errorf("replay of seed %d picked %s with seed %d, want %s", seed, id, e.Seed, picks[i])
+			}
+		}
+	}
+}
+
+// reassignRepo serves a pull request in a repository owned by another team
+// than its reviewers'.
+type reassignRepo struct {
+	repository.PRRepository
+	pr       models.PullRequest
+	owner    string
+	teams    map[string][]string
+	rules    map[string][]models.PairRule
+	replaced [2]string
+}
+
+func (r *reassignRepo) InTx(_ context.Context, fn func(repository.PRRepository) error) error {
+	return fn(r)
+}
+
+func (r *reassignRepo) GetPR(context.Context, string) (*models.PullRequest, error) {
+	pr := r.pr
+	return &pr, nil
+}
+
+func (r *reassignRepo) GetAuthorPairRules(_ context.Context, teamName, authorID string) ([]models.PairRule, error) {
+	var out []models.PairRule
+	for _, rule := range r.rules[teamName] {
+		if rule.AuthorID == authorID {
+			out = append(out, rule)
+		}
+	}
+	return out, nil
+}
+
+func (r *reassignRepo) GetRepository(_ context.Context, name string) (*models.Repository, error) {
+	return &models.Repository{Name: name, TeamName: r.owner}, nil
+}
+
+func (r *reassignRepo) GetActiveMembersExcluding(_ context.Context, teamName, excludeID string) ([]string, error) {
+	var out []string
+	for _, id := range r.teams[teamName] {
+		if id != excludeID {
+			out = append(out, id)
+		}
+	}
+	return out, nil
+}
+
+func (r *reassignRepo) GetTeam(_ context.Context, teamName string) (*models.Team, error) {
+	team := &models.Team{Name: teamName}
+	for _, id := range r.teams[teamName] {
+		team.Members = append(team.Members, models.Member{ID: id, IsActive: true})
+	}
+	return team, nil
+}
+
+func (r *reassignRepo) GetUserSchedules(context.Context, []string) (map[string]*models.WorkSchedule, error) {
+	return nil, nil
+}
+
+func (r *reassignRepo) ReassignReviewer(_ context.Context, _, oldUserID, newUserID string) (*models.PullRequest, error) {
+	r.replaced = [2]string{oldUserID, newUserID}
+	pr := r.pr
+	pr.AssignedReviewers = slices.Clone(pr.AssignedReviewers)
+	pr.AssignedReviewers[slices.Index(pr.AssignedReviewers, oldUserID)] = newUserID
+	return &pr, nil
+}
+
+func (r *reassignRepo) SaveExplanations(context.Context, string, []models.ReviewerExplanation) error {
+	return nil
+}
+
+func (r *reassignRepo) GetExplanations(context.Context, string) (map[string]models.ReviewerExplanation, error) {
+	return nil, nil
+}
+
+func TestReassignFromRepositoryTeam(t *testing.T) {
+	repo := &reassignRepo{
+		pr: models.PullRequest{
+			ID:                "api#1",
+			AuthorID:          "u1",
+			Status:            "OPEN",
+			Repository:        "api",
+			AssignedReviewers: []string{"u2", "p1"},
+		},
+		owner: "platform",
+		teams: map[string][]string{
+			"backend":  {"u1", "u2", "u3"},
+			"platform": {"p1", "p2"},
+		},
+	}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	s := NewPRService(repo, nil, logger, WithRand(rand.NewSource(1)))
+
+	// u2 is from the author's team, but api is reviewed by platform, so the
+	// replacement is p2 and never u3.
+	_, newUserID, err := s.ReassignReviewer(context.Background(), "api#1", "u2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if newUserID != "p2" || repo.replaced != [2]string{"u2", "p2"} {
+		t.Errorf("replaced %v with %s, want u2 with p2", repo.replaced, newUserID)
+	}
+}
+
+func TestPairRulesOfReviewingTeam(t *testing.T) {
+	repo := newExplanationRepo()
+	repo.teams["backend"] = []string{"u1", "u2"}
+	repo.rules = map[string][]models.PairRule{
+		// Rules of the author's team do not apply to pull requests
+		// reviewed by platform, where u2 cannot be a candidate.
+		"backend":  {{Kind: models.PairAlways, ReviewerID: "u2", AuthorID: "u1"}},
+		"platform": {{Kind: models.PairNever, ReviewerID: "p1", AuthorID: "u1"}},
+	}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	s := NewPRService(repo, nil, logger, WithRand(rand.NewSource(1)))
+	ctx := context.Background()
+
+	created, err := s.CreatePR(ctx, models.PullRequest{ID: "1", Name: "Add api", AuthorID: "u1", Repository: "api"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := slices.Sorted(slices.Values(created.AssignedReviewers)), []string{"p2", "p3"}; !slices.Equal(got, want) {
+		t.Fatalf("reviewers = %v, want %v", got, want)
+	}
+
+	// With p1 ruled out, nobody can replace p2.
+	if _, _, err := s.ReassignReviewer(ctx, created.ID, "p2"); !errors.Is(err, repository.ErrNoCandidate) {
+		t.Errorf("reassign err = %v, want %v", err, repository.ErrNoCandidate)
+	}
+
+	repo.rules["platform"] = []models.PairRule{{Kind: models.PairAlways, ReviewerID: "p2", AuthorID: "u1"}}
+	var rerr *RulesUnsatisfiableError
+	if _, _, err := s.ReassignReviewer(ctx, created.ID, "p2"); !errors.As(err, &rerr) {
+		t.Errorf("reassign err = %v, want a *RulesUnsatisfiableError", err)
+	}
+}
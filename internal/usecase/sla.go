@@ -0,0 +1,173 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/repository"
+)
+
+const maxReviewSLA = 90 * 24 * time.Hour
+
+func (s *prService) SetTeamSLA(ctx context.Context, sla models.TeamSLA) (*models.TeamSLA, error) {
+	if sla.TeamName == "" {
+		s.logger.Warn("invalid team name")
+		return nil, invalidArgument("team name required")
+	}
+	if sla.ReviewSLA < time.Minute || sla.ReviewSLA > maxReviewSLA {
+		return nil, invalidArgument("review sla must be between 1m and %s", maxReviewSLA)
+	}
+	if sla.ReassignAfter != 0 && (sla.ReassignAfter <= sla.ReviewSLA || sla.ReassignAfter > maxReviewSLA) {
+		return nil, invalidArgument("reassign_after must be longer than review sla and at most %s", maxReviewSLA)
+	}
+	sla.ReviewSLA = sla.ReviewSLA.Truncate(time.Second)
+	sla.ReassignAfter = sla.ReassignAfter.Truncate(time.Second)
+	sla.IsDefault = false
+
+	if err := s.repo.SetTeamSLA(ctx, sla); err != nil {
+		s.logger.Error("set team sla failed", "err", err)
+		return nil, fmt.Errorf("set team sla: %w", err)
+	}
+	return &sla, nil
+}
+
+// GetTeamSLA returns the team's settings, or the defaults when it has none.
+func (s *prService) GetTeamSLA(ctx context.Context, teamName string) (*models.TeamSLA, error) {
+	if teamName == "" {
+		s.logger.Warn("invalid team name")
+		return nil, invalidArgument("team name required")
+	}
+	sla, err := s.repo.GetTeamSLA(ctx, teamName)
+	if err != nil {
+		s.logger.Error("get team sla failed", "err", err)
+		return nil, fmt.Errorf("get team sla: %w", err)
+	}
+	if sla == nil {
+		sla = &models.TeamSLA{TeamName: teamName, ReviewSLA: models.DefaultReviewSLA, IsDefault: true}
+	}
+	return sla, nil
+}
+
+// ListOverdue returns open reviews past their SLA, most overdue first.
+func (s *prService) ListOverdue(ctx context.Context, filter models.OverdueFilter) ([]models.OverdueReview, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = defaultPageSize
+	}
+	if filter.Limit > maxPageSize {
+		filter.Limit = maxPageSize
+	}
+	if filter.TeamName != "" {
+		if _, err := s.repo.GetTeam(ctx, filter.TeamName); err != nil {
+			s.logger.Error("get team failed", "err", err)
+			return nil, fmt.Errorf("get team: %w", err)
+		}
+	}
+
+	overdue, err := s.overdue(ctx, filter, s.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+	if len(overdue) > filter.Limit {
+		overdue = overdue[:filter.Limit]
+	}
+	return overdue, nil
+}
+
+func (s *prService) overdue(ctx context.Context, filter models.OverdueFilter, now time.Time) ([]models.OverdueReview, error) {
+	assignments, err := s.repo.GetOpenAssignments(ctx, filter)
+	if err != nil {
+		s.logger.Error("get open assignments failed", "err", err)
+		return nil, fmt.Errorf("get open assignments: %w", err)
+	}
+
+	var out []models.OverdueReview
+	for _, a := range assignments {
+		slaDur, reassignAfter := models.DefaultReviewSLA, time.Duration(0)
+		if a.SLA != nil {
+			slaDur, reassignAfter = a.SLA.ReviewSLA, a.SLA.ReassignAfter
+		}
+		// Only the reviewer's working hours count towards the SLA.
+		due, ok := a.ReviewerSchedule.AddWorkingTime(a.AssignedAt, slaDur)
+		if !ok || !now.After(due) {
+			continue
+		}
+		o := models.OverdueReview{ReviewAssignment: a, DueAt: due, OverdueBy: now.Sub(due)}
+		if reassignAfter > 0 {
+			if at, ok := a.ReviewerSchedule.AddWorkingTime(a.AssignedAt, reassignAfter); ok {
+				o.ReassignAt = &at
+			}
+		}
+		out = append(out, o)
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].OverdueBy > out[j].OverdueBy })
+	return out, nil
+}
+
+// ProcessOverdue reminds reviewers once their SLA is exceeded and hands the
+// review to someone else once the team's reassign threshold is passed. Each
+// assignment is reminded and escalated at most once.
+func (s *prService) ProcessOverdue(ctx context.Context) (*models.SLARun, error) {
+	now := s.clock.Now()
+	overdue, err := s.overdue(ctx, models.OverdueFilter{}, now)
+	if err != nil {
+		return nil, err
+	}
+
+	run := &models.SLARun{}
+	var reminded, escalated []int64
+	var events []models.Event
+	for _, o := range overdue {
+		if o.ReassignAt != nil && o.EscalatedAt == nil && !now.Before(*o.ReassignAt) {
+			escalated = append(escalated, o.ID)
+			_, newUserID, err := s.ReassignReviewer(ctx, o.PullRequestID, o.ReviewerID)
+			switch {
+			case err == nil:
+				run.Reassigned = append(run.Reassigned, models.Reassignment{
+					PullRequestID: o.PullRequestID,
+					FromUserID:    o.ReviewerID,
+					ToUserID:      newUserID,
+				})
+				continue
+			case errors.Is(err, repository.ErrNoCandidate),
+				errors.Is(err, ErrPRMerged),
+				errors.Is(err, ErrNotAssigned),
+				errors.Is(err, ErrRulesUnsatisfiable):
+				// Nothing more can be done automatically; the reminder below
+				// is still sent if it was not yet.
+			default:
+				escalated = escalated[:len(escalated)-1]
+				run.Failed++
+				s.logger.Error("auto reassign failed", "pr_id", o.PullRequestID, "reviewer_id", o.ReviewerID, "err", err)
+			}
+		}
+		if o.RemindedAt == nil {
+			reminded = append(reminded, o.ID)
+			run.Reminded = append(run.Reminded, o)
+			events = append(events, models.Event{
+				Type:          models.EventReviewOverdue,
+				UserID:        o.ReviewerID,
+				PullRequestID: o.PullRequestID,
+				AuthorID:      o.AuthorID,
+			})
+		}
+	}
+
+	if len(escalated) > 0 {
+		if err := s.repo.MarkEscalated(ctx, escalated, now); err != nil {
+			s.logger.Error("mark escalated failed", "err", err)
+			return nil, fmt.Errorf("mark escalated: %w", err)
+		}
+	}
+	if len(reminded) > 0 {
+		if err := s.repo.MarkReminded(ctx, reminded, now); err != nil {
+			s.logger.Error("mark reminded failed", "err", err)
+			return nil, fmt.Errorf("mark reminded: %w", err)
+		}
+		s.publish(ctx, events...)
+	}
+	return run, nil
+}
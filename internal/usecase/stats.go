@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+)
+
+const (
+	defaultFairnessPeriod = 30 * 24 * time.Hour
+	fairnessOutliers      = 3
+)
+
+// GetFairness compares the reviews each member of a team got in a period with
+// the share expected from the time they were active. The period defaults to
+// the last 30 days.
+func (s *prService) GetFairness(ctx context.Context, filter models.FairnessFilter) (*models.FairnessReport, error) {
+	if filter.TeamName == "" {
+		s.logger.Warn("invalid team name")
+		return nil, invalidArgument("team name required")
+	}
+	if filter.To.IsZero() {
+		filter.To = s.clock.Now()
+	}
+	if filter.From.IsZero() {
+		filter.From = filter.To.Add(-defaultFairnessPeriod)
+	}
+	if !filter.From.Before(filter.To) {
+		return nil, fmt.Errorf("%w: from must be before to", ErrInvalidFilter)
+	}
+	if _, err := s.repo.GetTeam(ctx, filter.TeamName); err != nil {
+		s.logger.Error("get team failed", "err", err)
+		return nil, fmt.Errorf("get team: %w", err)
+	}
+
+	loads, err := s.repo.GetMemberLoads(ctx, filter)
+	if err != nil {
+		s.logger.Error("get member loads failed", "err", err)
+		return nil, fmt.Errorf("get member loads: %w", err)
+	}
+	return fairnessReport(filter, loads), nil
+}
+
+func fairnessReport(filter models.FairnessFilter, loads []models.MemberLoad) *models.FairnessReport {
+	report := &models.FairnessReport{TeamName: filter.TeamName, From: filter.From, To: filter.To}
+
+	var totalActive time.Duration
+	for _, l := range loads {
+		report.TotalReviews += l.Reviews
+		totalActive += l.ActiveTime
+	}
+
+	rates := make([]float64, 0, len(loads))
+	for _, l := range loads {
+		m := models.MemberFairness{MemberLoad: l, ActiveDays: l.ActiveTime.Hours() / 24}
+		if totalActive > 0 {
+			m.Expected = float64(report.TotalReviews) * float64(l.ActiveTime) / float64(totalActive)
+		}
+		m.Deviation = float64(l.Reviews) - m.Expected
+		report.Members = append(report.Members, m)
+		if m.ActiveDays > 0 {
+			rates = append(rates, float64(l.Reviews)/m.ActiveDays)
+		}
+	}
+	report.Gini = gini(rates)
+
+	ranked := make([]models.MemberFairness, len(report.Members))
+	copy(ranked, report.Members)
+	sort.SliceStable(ranked, func(i, j int) bool { return ranked[i].Deviation > ranked[j].Deviation })
+	for _, m := range ranked {
+		if len(report.OverAssigned) == fairnessOutliers || m.Deviation <= 0 {
+			break
+		}
+		report.OverAssigned = append(report.OverAssigned, m)
+	}
+	for i := len(ranked) - 1; i >= 0; i-- {
+		m := ranked[i]
+		if len(report.UnderAssigned) == fairnessOutliers || m.Deviation >= 0 {
+			break
+		}
+		report.UnderAssigned = append(report.UnderAssigned, m)
+	}
+	return report
+}
+
+// gini returns the Gini coefficient of values: 0 when all are equal, growing
+// towards 1 as they concentrate on fewer entries.
+func gini(values []float64) float64 {
+	n := len(values)
+	if n == 0 {
+		return 0
+	}
+	sorted := make([]float64, n)
+	copy(sorted, values)
+	sort.Float64s(sorted)
+
+	var sum, weighted float64
+	for i, v := range sorted {
+		sum += v
+		weighted += float64(i+1) * v
+	}
+	if sum == 0 {
+		return 0
+	}
+	g := (2*weighted)/(float64(n)*sum) - float64(n+1)/float64(n)
+	return math.Max(g, 0)
+}
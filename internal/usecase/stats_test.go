@@ -0,0 +1,145 @@
+package usecase
+
+import (
+	"math"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+)
+
+const day = 24 * time.Hour
+
+func TestGini(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []float64
+		want   float64
+	}{
+		{"empty", nil, 0},
+		{"single", []float64{5}, 0},
+		{"all equal", []float64{3, 3, 3, 3}, 0},
+		{"all zero", []float64{0, 0, 0}, 0},
+		{"one of two carries all", []float64{0, 4}, 0.5},
+		{"one of four carries all", []float64{0, 0, 7, 0}, 0.75},
+		{"order does not matter", []float64{3, 1, 2}, 2.0 / 9},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := gini(tt.values); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("gini(%v) = %v, want %v", tt.values, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFairnessReport(t *testing.T) {
+	filter := models.FairnessFilter{TeamName: "backend"}
+	tests := []struct {
+		name     string
+		loads    []models.MemberLoad
+		total    int
+		gini     float64
+		expected map[string]float64
+		over     []string
+		under    []string
+	}{
+		{
+			name: "empty team",
+		},
+		{
+			name: "all equal",
+			loads: []models.MemberLoad{
+				{UserID: "u1", Reviews: 4, ActiveTime: 10 * day},
+				{UserID: "u2", Reviews: 4, ActiveTime: 10 * day},
+				{UserID: "u3", Reviews: 4, ActiveTime: 10 * day},
+			},
+			total:    12,
+			expected: map[string]float64{"u1": 4, "u2": 4, "u3": 4},
+		},
+		{
+			name: "one reviewer carries all",
+			loads: []models.MemberLoad{
+				{UserID: "u1", Reviews: 9, ActiveTime: 10 * day},
+				{UserID: "u2", ActiveTime: 10 * day},
+				{UserID: "u3", ActiveTime: 10 * day},
+			},
+			total:    9,
+			gini:     2.0 / 3,
+			expected: map[string]float64{"u1": 3, "u2": 3, "u3": 3},
+			over:     []string{"u1"},
+			under:    []string{"u3", "u2"},
+		},
+		{
+			name: "expected follows active time",
+			loads: []models.MemberLoad{
+				{UserID: "u1", Reviews: 3, ActiveTime: 20 * day},
+				{UserID: "u2", Reviews: 3, ActiveTime: 10 * day},
+			},
+			total:    6,
+			gini:     1.0 / 6,
+			expected: map[string]float64{"u1": 4, "u2": 2},
+			over:     []string{"u2"},
+			under:    []string{"u1"},
+		},
+		{
+			name: "member with zero active days",
+			loads: []models.MemberLoad{
+				{UserID: "u1", Reviews: 2, ActiveTime: 10 * day},
+				{UserID: "u2", Reviews: 2, ActiveTime: 10 * day},
+				{UserID: "u3", Reviews: 1},
+			},
+			total:    5,
+			expected: map[string]float64{"u1": 2.5, "u2": 2.5, "u3": 0},
+			over:     []string{"u3"},
+			under:    []string{"u2", "u1"},
+		},
+		{
+			name: "nobody active",
+			loads: []models.MemberLoad{
+				{UserID: "u1", Reviews: 2},
+				{UserID: "u2"},
+			},
+			total:    2,
+			expected: map[string]float64{"u1": 0, "u2": 0},
+			over:     []string{"u1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := fairnessReport(filter, tt.loads)
+			if report.TeamName != filter.TeamName || report.TotalReviews != tt.total {
+				t.Errorf("team %q with %d reviews, want %q with %d", report.TeamName, report.TotalReviews, filter.TeamName, tt.total)
+			}
+			if math.Abs(report.Gini-tt.gini) > 1e-9 {
+				t.Errorf("gini = %v, want %v", report.Gini, tt.gini)
+			}
+			if len(report.Members) != len(tt.loads) {
+				t.Fatalf("%d members, want %d", len(report.Members), len(tt.loads))
+			}
+			for _, m := range report.Members {
+				if want := tt.expected[m.UserID]; math.Abs(m.Expected-want) > 1e-9 {
+					t.Errorf("%s expected = %v, want %v", m.UserID, m.Expected, want)
+				}
+				if want := float64(m.Reviews) - m.Expected; math.Abs(m.Deviation-want) > 1e-9 {
+					t.Errorf("%s deviation = %v, want %v", m.UserID, m.Deviation, want)
+				}
+			}
+			if got := memberIDs(report.OverAssigned); !slices.Equal(got, tt.over) {
+				t.Errorf("over assigned = %v, want %v", got, tt.over)
+			}
+			if got := memberIDs(report.UnderAssigned); !slices.Equal(got, tt.under) {
+				t.Errorf("under assigned = %v, want %v", got, tt.under)
+			}
+		})
+	}
+}
+
+func memberIDs(members []models.MemberFairness) []string {
+	var ids []string
+	for _, m := range members {
+		ids = append(ids, m.UserID)
+	}
+	return ids
+}
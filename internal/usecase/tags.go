@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+func (s *prService) SetUserTags(ctx context.Context, userID string, tags []string) ([]string, error) {
+	if userID == "" {
+		s.logger.Warn("invalid user id")
+		return nil, invalidArgument("user id required")
+	}
+	tags = normalizeTags(tags)
+
+	stored, err := s.repo.SetUserTags(ctx, userID, tags)
+	if err != nil {
+		s.logger.Error("set tags failed", "err", err)
+		return nil, fmt.Errorf("set tags: %w", err)
+	}
+	return stored, nil
+}
+
+// normalizeTags lowercases and trims tags and drops empty and duplicate ones,
+// keeping order.
+func normalizeTags(tags []string) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+	seen := make(map[string]bool, len(tags))
+	out := make([]string, 0, len(tags))
+	for _, t := range tags {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if t == "" || seen[t] {
+			continue
+		}
+		seen[t] = true
+		out = append(out, t)
+	}
+	return out
+}
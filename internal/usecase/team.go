@@ -0,0 +1,462 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/repository"
+)
+
+func (s *prService) UpdateTeam(ctx context.Context, team models.Team, policy models.OpenPRPolicy) (*models.Team, error) {
+	if err := s.validateTeam(team); err != nil {
+		return nil, err
+	}
+	policy, err := s.checkPolicy(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []models.Event
+	err = s.inTx(ctx, func(tx *prService) error {
+		current, err := tx.repo.GetTeam(ctx, team.Name)
+		if err != nil {
+			s.logger.Error("get team failed", "err", err)
+			return fmt.Errorf("get team: %w", err)
+		}
+
+		keep := make(map[string]bool, len(team.Members))
+		for _, m := range team.Members {
+			keep[m.ID] = true
+		}
+		var removed []string
+		for _, m := range current.Members {
+			if !keep[m.ID] {
+				removed = append(removed, m.ID)
+			}
+		}
+
+		if events, err = tx.releaseUsers(ctx, removed, policy); err != nil {
+			return err
+		}
+		joined, err := tx.joinUsers(ctx, team, policy)
+		if err != nil {
+			return err
+		}
+		events = append(events, joined...)
+
+		movePolicy := models.MovePolicyKeep
+		if policy == models.OpenPRPolicyReassign {
+			movePolicy = models.MovePolicyReassign
+		}
+		if err := tx.repo.CreateOrUpdateTeam(ctx, team, movePolicy); err != nil {
+			s.logger.Error("update team failed", "err", err)
+			return fmt.Errorf("update team: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.publish(ctx, events...)
+
+	updated, err := s.repo.GetTeam(ctx, team.Name)
+	if err != nil {
+		s.logger.Error("get updated team failed", "err", err)
+		return nil, fmt.Errorf("get team: %w", err)
+	}
+	return updated, nil
+}
+
+func (s *prService) RemoveTeamMember(ctx context.Context, teamName, userID string, policy models.OpenPRPolicy) (*models.Team, error) {
+	if teamName == "" || userID == "" {
+		s.logger.Warn("invalid remove member data")
+		return nil, invalidArgument("fields required")
+	}
+	policy, err := s.checkPolicy(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []models.Event
+	err = s.inTx(ctx, func(tx *prService) error {
+		team, err := tx.repo.GetTeam(ctx, teamName)
+		if err != nil {
+			s.logger.Error("get team failed", "err", err)
+			return fmt.Errorf("get team: %w", err)
+		}
+		member := false
+		for _, m := range team.Members {
+			if m.ID == userID {
+				member = true
+				break
+			}
+		}
+		if !member {
+			return ErrNotTeamMember
+		}
+
+		events, err = tx.releaseUsers(ctx, []string{userID}, policy)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.publish(ctx, events...)
+
+	updated, err := s.repo.GetTeam(ctx, teamName)
+	if err != nil {
+		s.logger.Error("get updated team failed", "err", err)
+		return nil, fmt.Errorf("get team: %w", err)
+	}
+	return updated, nil
+}
+
+// DeleteTeam removes the team and detaches its members, returning their ids.
+func (s *prService) DeleteTeam(ctx context.Context, teamName string, policy models.OpenPRPolicy) ([]string, error) {
+	if teamName == "" {
+		s.logger.Warn("invalid team name")
+		return nil, invalidArgument("team name required")
+	}
+	policy, err := s.checkPolicy(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		members []string
+		events  []models.Event
+	)
+	err = s.inTx(ctx, func(tx *prService) error {
+		team, err := tx.repo.GetTeam(ctx, teamName)
+		if err != nil {
+			s.logger.Error("get team failed", "err", err)
+			return fmt.Errorf("get team: %w", err)
+		}
+		members = make([]string, 0, len(team.Members))
+		for _, m := range team.Members {
+			members = append(members, m.ID)
+		}
+
+		if events, err = tx.releaseUsers(ctx, members, policy); err != nil {
+			return err
+		}
+
+		if err := tx.repo.DeleteTeam(ctx, teamName); err != nil {
+			s.logger.Error("delete team failed", "err", err)
+			return fmt.Errorf("delete team: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.publish(ctx, events...)
+	return members, nil
+}
+
+// joinUsers prepares members of other teams for moving into team: with the
+// reassign policy their open reviews are handed over within their old teams,
+// with refuse any open review aborts the update.
+func (s *prService) joinUsers(ctx context.Context, team models.Team, policy models.OpenPRPolicy) ([]models.Event, error) {
+	ids := make([]string, 0, len(team.Members))
+	for _, m := range team.Members {
+		ids = append(ids, m.ID)
+	}
+	users, err := s.repo.GetUsers(ctx, ids)
+	if err != nil {
+		s.logger.Error("get users failed", "err", err)
+		return nil, fmt.Errorf("get users: %w", err)
+	}
+
+	var events []models.Event
+	for _, u := range users {
+		if u.TeamName == "" || u.TeamName == team.Name {
+			continue
+		}
+		if policy == models.OpenPRPolicyRefuse {
+			prs, err := s.repo.GetOpenPRsByUsers(ctx, []string{u.ID})
+			if err != nil {
+				s.logger.Error("get open prs failed", "err", err)
+				return nil, fmt.Errorf("get open prs: %w", err)
+			}
+			var reviewing []string
+			for _, pr := range prs {
+				if slices.Contains(pr.AssignedReviewers, u.ID) {
+					reviewing = append(reviewing, pr.ID)
+				}
+			}
+			if len(reviewing) > 0 {
+				s.logger.Warn("joining user has open reviews", "user", u.ID, "prs", reviewing)
+				return nil, &OpenPRsError{PullRequestIDs: reviewing}
+			}
+			continue
+		}
+		_, moved, err := s.handOverMoved(ctx, u.ID, u.TeamName)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, moved...)
+	}
+	return events, nil
+}
+
+// handOverMoved hands the open reviews of a user leaving oldTeam over to
+// other members of that team, reporting which pull requests got a new
+// reviewer and which were left without one. It is meant to run inside a
+// transaction, so the review events are returned for publishing after commit.
+func (s *prService) handOverMoved(ctx context.Context, userID, oldTeam string) (*models.ReviewHandover, []models.Event, error) {
+	prs, err := s.repo.GetOpenPRsByUsers(ctx, []string{userID})
+	if err != nil {
+		s.logger.Error("get open prs failed", "err", err)
+		return nil, nil, fmt.Errorf("get open prs: %w", err)
+	}
+	var (
+		handover = &models.ReviewHandover{}
+		events   []models.Event
+	)
+	exclude := map[string]bool{userID: true}
+	for i := range prs {
+		pr := &prs[i]
+		if !slices.Contains(pr.AssignedReviewers, userID) {
+			continue
+		}
+		updated, prEvents, err := s.handOverWithin(ctx, pr, oldTeam, userID, exclude)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(updated.AssignedReviewers) < len(pr.AssignedReviewers) {
+			handover.Removed = append(handover.Removed, pr.ID)
+		} else {
+			handover.Reassigned = append(handover.Reassigned, pr.ID)
+		}
+		events = append(events, prEvents...)
+	}
+	return handover, events, nil
+}
+
+func (s *prService) checkPolicy(policy models.OpenPRPolicy) (models.OpenPRPolicy, error) {
+	switch policy {
+	case "":
+		return models.OpenPRPolicyRefuse, nil
+	case models.OpenPRPolicyRefuse, models.OpenPRPolicyReassign:
+		return policy, nil
+	default:
+		s.logger.Warn("invalid open prs policy", "policy", policy)
+		return "", ErrInvalidPolicy
+	}
+}
+
+// releaseUsers detaches users from their team. Open pull requests they review
+// are handed over to other team members (or left without that reviewer when
+// nobody is available); with the refuse policy any open pull request, authored
+// or reviewed, aborts the operation. It is meant to run inside a transaction,
+// so the review events are returned for publishing after commit.
+func (s *prService) releaseUsers(ctx context.Context, userIDs []string, policy models.OpenPRPolicy) ([]models.Event, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+
+	prs, err := s.repo.GetOpenPRsByUsers(ctx, userIDs)
+	if err != nil {
+		s.logger.Error("get open prs failed", "err", err)
+		return nil, fmt.Errorf("get open prs: %w", err)
+	}
+	if len(prs) > 0 && policy == models.OpenPRPolicyRefuse {
+		ids := make([]string, 0, len(prs))
+		for _, pr := range prs {
+			ids = append(ids, pr.ID)
+		}
+		s.logger.Warn("users have open prs", "users", userIDs, "prs", ids)
+		return nil, &OpenPRsError{PullRequestIDs: ids}
+	}
+
+	events, err := s.handOverLeaving(ctx, prs, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.DetachUsers(ctx, userIDs); err != nil {
+		s.logger.Error("detach users failed", "err", err)
+		return nil, fmt.Errorf("detach users: %w", err)
+	}
+	return events, nil
+}
+
+// handOverLeaving hands the reviews the given users hold on prs over to other
+// members of each reviewing team, never to one of the leaving users.
+func (s *prService) handOverLeaving(ctx context.Context, prs []models.PullRequest, userIDs []string) ([]models.Event, error) {
+	var events []models.Event
+	leaving := make(map[string]bool, len(userIDs))
+	for _, id := range userIDs {
+		leaving[id] = true
+	}
+	for i := range prs {
+		pr := &prs[i]
+		for _, reviewer := range append([]string(nil), pr.AssignedReviewers...) {
+			if !leaving[reviewer] {
+				continue
+			}
+			updated, handover, err := s.handOverReview(ctx, pr, reviewer, leaving)
+			if err != nil {
+				return nil, err
+			}
+			pr = updated
+			events = append(events, handover...)
+		}
+	}
+	return events, nil
+}
+
+// handOverReview replaces reviewerID on an open pull request with a random
+// active member of the reviewing team, preferring those in working hours and
+// skipping excluded users and current reviewers. In a mentorship team only
+// seniors are considered while no other reviewer is senior. Without a
+// candidate the reviewer is just removed. The review events are returned
+// rather than published, since callers run it inside a transaction.
+func (s *prService) handOverReview(ctx context.Context, pr *models.PullRequest, reviewerID string, exclude map[string]bool) (*models.PullRequest, []models.Event, error) {
+	teamName, err := s.reviewTeam(ctx, pr)
+	if err != nil && !errors.Is(err, repository.ErrUserNoTeam) {
+		return nil, nil, err
+	}
+	return s.handOverWithin(ctx, pr, teamName, reviewerID, exclude)
+}
+
+// handOverWithin is handOverReview with the candidates drawn from teamName;
+// an empty team name leaves nobody to hand over to.
+func (s *prService) handOverWithin(ctx context.Context, pr *models.PullRequest, teamName, reviewerID string, exclude map[string]bool) (*models.PullRequest, []models.Event, error) {
+	var (
+		candidates []string
+		team       *models.Team
+	)
+	reasons := map[string]string{pr.AuthorID: models.ExclusionAuthor, reviewerID: models.ExclusionReplaced}
+	if teamName != "" {
+		members, err := s.repo.GetActiveMembersExcluding(ctx, teamName, pr.AuthorID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("get active members: %w", err)
+		}
+		// The reviewer is leaving, so "always" rules cannot hold them; only
+		// "never" rules still narrow the candidates.
+		rules, err := s.pairConstraints(ctx, teamName, pr.AuthorID)
+		if err != nil {
+			return nil, nil, err
+		}
+		members = rules.filter(members, reasons)
+		if team, err = s.repo.GetTeam(ctx, teamName); err != nil {
+			return nil, nil, fmt.Errorf("get team: %w", err)
+		}
+		assigned := make(map[string]bool, len(pr.AssignedReviewers))
+		for _, r := range pr.AssignedReviewers {
+			assigned[r] = true
+		}
+		for _, m := range members {
+			switch {
+			case m == reviewerID:
+			case exclude[m]:
+				reasons[m] = models.ExclusionUnavailable
+			case assigned[m]:
+				reasons[m] = models.ExclusionAssigned
+			default:
+				candidates = append(candidates, m)
+			}
+		}
+	}
+
+	if len(candidates) == 0 {
+		updated, err := s.repo.RemoveReviewer(ctx, pr.ID, reviewerID)
+		if err != nil {
+			s.logger.Error("remove reviewer failed", "pr", pr.ID, "err", err)
+			return nil, nil, fmt.Errorf("remove reviewer: %w", err)
+		}
+		s.logger.Info("reviewer removed", "pr", pr.ID, "user", reviewerID)
+		return updated, reviewEvents(models.EventReviewUnassigned, updated, reviewerID), nil
+	}
+
+	pool, seniorOnly, violations, err := s.seniorReplacement(ctx, team, pr, reviewerID, candidates)
+	if err != nil {
+		return nil, nil, err
+	}
+	newUserID, explanation, err := s.pickReviewer(ctx, pr.ID, pool)
+	if err != nil {
+		return nil, nil, fmt.Errorf("pick reviewer: %w", err)
+	}
+	if seniorOnly {
+		explanation.Strategy = models.StrategySenior
+	}
+	explanation.Excluded = exclusions(team, candidates, reasons)
+	updated, err := s.repo.ReassignReviewer(ctx, pr.ID, reviewerID, newUserID)
+	if err != nil {
+		s.logger.Error("reassign failed", "pr", pr.ID, "err", err)
+		return nil, nil, fmt.Errorf("reassign: %w", err)
+	}
+	if err := s.saveExplanations(ctx, pr.ID, []models.ReviewerExplanation{explanation}); err != nil {
+		return nil, nil, err
+	}
+	updated.PolicyViolations = violations
+	s.logger.Info("review handed over", "pr", pr.ID, "from", reviewerID, "to", newUserID)
+	events := append(
+		reviewEvents(models.EventReviewUnassigned, updated, reviewerID),
+		reviewEvents(models.EventReviewAssigned, updated, newUserID)...)
+	return updated, events, nil
+}
+
+// MoveUser transfers a user to another team. With the reassign policy the
+// user's open reviews are handed over to members of the old team in the same
+// transaction as the move; the affected pull requests are returned, split into
+// those that got a new reviewer and those left without one.
+func (s *prService) MoveUser(ctx context.Context, userID, teamName string, policy models.MovePolicy) (*models.User, *models.ReviewHandover, error) {
+	if userID == "" || teamName == "" {
+		s.logger.Warn("invalid move data")
+		return nil, nil, invalidArgument("fields required")
+	}
+	switch policy {
+	case "":
+		policy = models.MovePolicyReassign
+	case models.MovePolicyKeep, models.MovePolicyReassign:
+	default:
+		s.logger.Warn("invalid move policy", "policy", policy)
+		return nil, nil, ErrInvalidPolicy
+	}
+
+	var (
+		user     *models.User
+		oldTeam  string
+		handover = &models.ReviewHandover{}
+		events   []models.Event
+	)
+	err := s.inTx(ctx, func(tx *prService) error {
+		var err error
+		oldTeam, err = tx.repo.GetUserTeam(ctx, userID)
+		if err != nil && !errors.Is(err, repository.ErrUserNoTeam) {
+			s.logger.Error("get user team failed", "err", err)
+			return fmt.Errorf("get user team: %w", err)
+		}
+		if oldTeam == teamName {
+			return ErrAlreadyInTeam
+		}
+		if _, err := tx.repo.GetTeam(ctx, teamName); err != nil {
+			s.logger.Error("get target team failed", "err", err)
+			return fmt.Errorf("get team: %w", err)
+		}
+
+		if policy == models.MovePolicyReassign && oldTeam != "" {
+			if handover, events, err = tx.handOverMoved(ctx, userID, oldTeam); err != nil {
+				return err
+			}
+		}
+
+		if user, err = tx.repo.MoveUser(ctx, userID, teamName, policy); err != nil {
+			s.logger.Error("move user failed", "err", err)
+			return fmt.Errorf("move user: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	s.publish(ctx, events...)
+	s.logger.Info("user moved", "user", userID, "from", oldTeam, "to", teamName, "policy", policy)
+	return user, handover, nil
+}
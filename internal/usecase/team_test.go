@@ -0,0 +1,290 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"maps"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/repository"
+)
+
+// teamRepo keeps teams and open pull requests in memory for team updates.
+// Transactions run inline; other repository calls panic on the nil embedded
+// interface.
+type teamRepo struct {
+	repository.PRRepository
+	users    map[string]*models.User
+	prs      []models.PullRequest
+	policies map[string]models.MovePolicy
+	locked   bool
+}
+
+func (r *teamRepo) InTx(_ context.Context, fn func(repository.PRRepository) error) error {
+	return fn(r)
+}
+
+func (r *teamRepo) GetTeam(_ context.Context, teamName string) (*models.Team, error) {
+	team := &models.Team{Name: teamName}
+	for _, id := range slices.Sorted(maps.Keys(r.users)) {
+		if u := r.users[id]; u.TeamName == teamName {
+			team.Members = append(team.Members, models.Member{ID: u.ID, Username: u.Username, IsActive: u.IsActive})
+		}
+	}
+	return team, nil
+}
+
+func (r *teamRepo) GetUsers(_ context.Context, ids []string) ([]models.User, error) {
+	var out []models.User
+	for _, id := range ids {
+		if u, ok := r.users[id]; ok {
+			out = append(out, *u)
+		}
+	}
+	return out, nil
+}
+
+func (r *teamRepo) GetOpenPRsByUsers(_ context.Context, ids []string) ([]models.PullRequest, error) {
+	var out []models.PullRequest
+	for _, pr := range r.prs {
+		if slices.Contains(ids, pr.AuthorID) || slices.ContainsFunc(pr.AssignedReviewers, func(id string) bool {
+			return slices.Contains(ids, id)
+		}) {
+			pr.AssignedReviewers = slices.Clone(pr.AssignedReviewers)
+			out = append(out, pr)
+		}
+	}
+	return out, nil
+}
+
+func (r *teamRepo) GetActiveMembersExcluding(_ context.Context, teamName, excludeID string) ([]string, error) {
+	var out []string
+	for _, id := range slices.Sorted(maps.Keys(r.users)) {
+		if u := r.users[id]; u.TeamName == teamName && u.IsActive && id != excludeID {
+			out = append(out, id)
+		}
+	}
+	return out, nil
+}
+
+func (r *teamRepo) GetAuthorPairRules(context.Context, string, string) ([]models.PairRule, error) {
+	return nil, nil
+}
+
+func (r *teamRepo) GetUserSchedules(context.Context, []string) (map[string]*models.WorkSchedule, error) {
+	return nil, nil
+}
+
+func (r *teamRepo) SaveExplanations(context.Context, string, []models.ReviewerExplanation) error {
+	return nil
+}
+
+func (r *teamRepo) ReassignReviewer(_ context.Context, prID, oldUserID, newUserID string) (*models.PullRequest, error) {
+	for i := range r.prs {
+		pr := &r.prs[i]
+		if pr.ID == prID {
+			pr.AssignedReviewers[slices.Index(pr.AssignedReviewers, oldUserID)] = newUserID
+			out := *pr
+			return &out, nil
+		}
+	}
+	return nil, repository.ErrPRNotFound
+}
+
+func (r *teamRepo) CreateOrUpdateTeam(_ context.Context, team models.Team, policy models.MovePolicy) error {
+	for _, m := range team.Members {
+		u, ok := r.users[m.ID]
+		if !ok {
+			u = &models.User{ID: m.ID}
+			r.users[m.ID] = u
+		}
+		if u.TeamName != team.Name {
+			r.policies[m.ID] = policy
+		}
+		u.Username, u.TeamName, u.IsActive = m.Username, team.Name, m.IsActive
+	}
+	return nil
+}
+
+func (r *teamRepo) GetRepository(_ context.Context, name string) (*models.Repository, error) {
+	return &models.Repository{Name: name}, nil
+}
+
+func (r *teamRepo) GetUserTeam(_ context.Context, userID string) (string, error) {
+	u, ok := r.users[userID]
+	if !ok {
+		return "", repository.ErrUserNotFound
+	}
+	return u.TeamName, nil
+}
+
+func (r *teamRepo) ListTeams(ctx context.Context, names []string) ([]models.Team, error) {
+	var teams []models.Team
+	for _, name := range names {
+		team, _ := r.GetTeam(ctx, name)
+		if len(team.Members) > 0 {
+			teams = append(teams, *team)
+		}
+	}
+	return teams, nil
+}
+
+func (r *teamRepo) LockRoster(context.Context, []string, []string) error {
+	r.locked = true
+	return nil
+}
+
+func (r *teamRepo) ImportTeams(ctx context.Context, teams []models.Team, deactivate []string) error {
+	if !r.locked {
+		return errors.New("import outside a locked roster")
+	}
+	for _, team := range teams {
+		if err := r.CreateOrUpdateTeam(ctx, team, models.MovePolicyReassign); err != nil {
+			return err
+		}
+	}
+	for _, id := range deactivate {
+		r.users[id].IsActive = false
+	}
+	return nil
+}
+
+func newTeamRepo() *teamRepo {
+	users := map[string]*models.User{}
+	for _, u := range []models.User{
+		{ID: "a1", TeamName: "backend", IsActive: true},
+		{ID: "a2", TeamName: "backend", IsActive: true},
+		{ID: "a3", TeamName: "backend", IsActive: true},
+		{ID: "f1", TeamName: "frontend", IsActive: true},
+	} {
+		users[u.ID] = &u
+	}
+	return &teamRepo{
+		users: users,
+		// a3 reviews a backend pull request and is moving to frontend.
+		prs:      []models.PullRequest{{ID: "pr-1", AuthorID: "a1", Status: "OPEN", AssignedReviewers: []string{"a3"}}},
+		policies: map[string]models.MovePolicy{},
+	}
+}
+
+func TestUpdateTeamJoiningMembers(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	frontend := models.Team{Name: "frontend", Members: []models.Member{
+		{ID: "f1", Username: "f1", IsActive: true},
+		{ID: "a3", Username: "a3", IsActive: true},
+	}}
+
+	t.Run("refuse", func(t *testing.T) {
+		repo := newTeamRepo()
+		s := NewPRService(repo, nil, logger, WithRand(rand.NewSource(1)))
+		_, err := s.UpdateTeam(context.Background(), frontend, models.OpenPRPolicyRefuse)
+		var openErr *OpenPRsError
+		if !errors.As(err, &openErr) || !slices.Equal(openErr.PullRequestIDs, []string{"pr-1"}) {
+			t.Fatalf("err = %v, want open prs [pr-1]", err)
+		}
+		if repo.users["a3"].TeamName != "backend" {
+			t.Errorf("a3 moved to %s", repo.users["a3"].TeamName)
+		}
+	})
+
+	t.Run("reassign", func(t *testing.T) {
+		repo := newTeamRepo()
+		s := NewPRService(repo, nil, logger, WithRand(rand.NewSource(1)))
+		team, err := s.UpdateTeam(context.Background(), frontend, models.OpenPRPolicyReassign)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(team.Members) != 2 || repo.users["a3"].TeamName != "frontend" {
+			t.Errorf("members = %+v", team.Members)
+		}
+		// The review stays in backend and never goes to the author.
+		if got := repo.prs[0].AssignedReviewers; !slices.Equal(got, []string{"a2"}) {
+			t.Errorf("reviewers = %v, want [a2]", got)
+		}
+		if repo.policies["a3"] != models.MovePolicyReassign {
+			t.Errorf("move recorded with %q", repo.policies["a3"])
+		}
+	})
+}
+
+func TestImportTeamsHandsOverReviews(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	teams := []models.Team{
+		// a2 is left out of backend and gets deactivated.
+		{Name: "backend", Members: []models.Member{
+			{ID: "a1", Username: "a1", IsActive: true},
+			{ID: "a3", Username: "a3", IsActive: true},
+		}},
+		// a4 joins from backend, where it reviews pr-2.
+		{Name: "frontend", Members: []models.Member{
+			{ID: "f1", Username: "f1", IsActive: true},
+			{ID: "a4", Username: "a4", IsActive: true},
+		}},
+	}
+	setup := func() *teamRepo {
+		repo := newTeamRepo()
+		repo.users["a4"] = &models.User{ID: "a4", Username: "a4", TeamName: "backend", IsActive: true}
+		for _, u := range repo.users {
+			u.Username = u.ID
+		}
+		repo.prs = []models.PullRequest{
+			{ID: "pr-1", AuthorID: "a1", Status: "OPEN", AssignedReviewers: []string{"a2"}},
+			{ID: "pr-2", AuthorID: "a1", Status: "OPEN", AssignedReviewers: []string{"a4"}},
+		}
+		return repo
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		repo := setup()
+		s := NewPRService(repo, nil, logger, WithRand(rand.NewSource(1)))
+		res, err := s.ImportTeams(context.Background(), teams, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Applied || len(res.Moved) != 1 || len(res.Deactivated) != 1 {
+			t.Errorf("result = %+v", res)
+		}
+		if !repo.users["a2"].IsActive || repo.users["a4"].TeamName != "backend" {
+			t.Error("dry run changed users")
+		}
+	})
+
+	t.Run("apply", func(t *testing.T) {
+		repo := setup()
+		s := NewPRService(repo, nil, logger, WithRand(rand.NewSource(1)))
+		res, err := s.ImportTeams(context.Background(), teams, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !res.Applied {
+			t.Fatalf("result = %+v", res)
+		}
+		if repo.users["a2"].IsActive || repo.users["a4"].TeamName != "frontend" {
+			t.Errorf("a2 = %+v, a4 = %+v", repo.users["a2"], repo.users["a4"])
+		}
+		// Only a3 is left in backend to take over; neither the deactivated
+		// a2 nor the departed a4 may be picked for the other's review.
+		for _, pr := range repo.prs {
+			if !slices.Equal(pr.AssignedReviewers, []string{"a3"}) {
+				t.Errorf("%s reviewers = %v, want [a3]", pr.ID, pr.AssignedReviewers)
+			}
+		}
+		if repo.policies["a4"] != models.MovePolicyReassign {
+			t.Errorf("move recorded with %q", repo.policies["a4"])
+		}
+	})
+
+	t.Run("invalid user id", func(t *testing.T) {
+		repo := setup()
+		s := NewPRService(repo, nil, logger)
+		bad := []models.Team{{Name: "backend", Members: []models.Member{{ID: "api#1", Username: "x", IsActive: true}}}}
+		if _, err := s.ImportTeams(context.Background(), bad, false); !errors.Is(err, ErrInvalidArgument) {
+			t.Fatalf("err = %v, want invalid argument", err)
+		}
+	})
+}
@@ -5,48 +5,87 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
-	"math/rand"
+	"slices"
+	"strings"
 
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/clock"
 	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
 	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/repository"
 )
 
 type prService struct {
 	repo   repository.PRRepository
+	events EventPublisher
+	clock  clock.Clock
+	seeds  *seedSource
 	logger *slog.Logger
 }
 
-func NewPRService(repo repository.PRRepository, logger *slog.Logger) PRService {
-	return &prService{repo: repo, logger: logger}
+type Option func(*prService)
+
+// WithClock replaces the system clock, which decides working hours and
+// review deadlines.
+func WithClock(c clock.Clock) Option {
+	return func(s *prService) {
+		s.clock = c
+	}
 }
 
-func (s *prService) CreateTeam(ctx context.Context, team models.Team) (*models.Team, error) {
+func NewPRService(repo repository.PRRepository, events EventPublisher, logger *slog.Logger, opts ...Option) PRService {
+	s := &prService{repo: repo, events: events, clock: clock.Real{}, seeds: defaultSeeds(), logger: logger}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// inTx runs fn against a copy of the service whose repository is bound to a
+// single transaction. fn must not publish events; they are only safe to send
+// once the transaction has committed.
+func (s *prService) inTx(ctx context.Context, fn func(tx *prService) error) error {
+	return s.repo.InTx(ctx, func(repo repository.PRRepository) error {
+		tx := *s
+		tx.repo = repo
+		return fn(&tx)
+	})
+}
+
+func (s *prService) validateTeam(team models.Team) error {
 	if team.Name == "" {
 		s.logger.Warn("invalid team name")
-		return nil, errors.New("team name required")
+		return invalidArgument("team name required")
 	}
 	if len(team.Members) == 0 {
 		s.logger.Warn("no members")
-		return nil, errors.New("members required")
+		return invalidArgument("members required")
 	}
 	for _, m := range team.Members {
 		if m.ID == "" || m.Username == "" {
 			s.logger.Warn("invalid member", "id", m.ID)
-			return nil, errors.New("invalid member data")
+			return invalidArgument("invalid member data")
 		}
 	}
+	return nil
+}
+
+func (s *prService) CreateTeam(ctx context.Context, team models.Team) (*models.Team, error) {
+	if err := s.validateTeam(team); err != nil {
+		return nil, err
+	}
 
 	_, err := s.repo.GetTeam(ctx, team.Name)
 	if err == nil {
 		s.logger.Warn("team already exists", "name", team.Name)
-		return nil, errors.New("TEAM_EXISTS")
+		return nil, ErrTeamExists
 	}
 	if !errors.Is(err, repository.ErrTeamNotFound) {
 		s.logger.Error("failed to check team existence", "err", err)
 		return nil, fmt.Errorf("check team exists: %w", err)
 	}
 
-	if err := s.repo.CreateOrUpdateTeam(ctx, team); err != nil {
+	// Like the original /team/add, members of other teams move here and keep
+	// their reviews.
+	if err := s.repo.CreateOrUpdateTeam(ctx, team, models.MovePolicyKeep); err != nil {
 		s.logger.Error("create team failed", "err", err)
 		return nil, fmt.Errorf("create team: %w", err)
 	}
@@ -62,7 +101,7 @@ func (s *prService) CreateTeam(ctx context.Context, team models.Team) (*models.T
 func (s *prService) GetTeam(ctx context.Context, teamName string) (*models.Team, error) {
 	if teamName == "" {
 		s.logger.Warn("invalid team name")
-		return nil, errors.New("team name required")
+		return nil, invalidArgument("team name required")
 	}
 	team, err := s.repo.GetTeam(ctx, teamName)
 	if err != nil {
@@ -75,7 +114,7 @@ func (s *prService) GetTeam(ctx context.Context, teamName string) (*models.Team,
 func (s *prService) SetUserActive(ctx context.Context, userID string, isActive bool) (*models.User, error) {
 	if userID == "" {
 		s.logger.Warn("invalid user id")
-		return nil, errors.New("user id required")
+		return nil, invalidArgument("user id required")
 	}
 	user, err := s.repo.SetUserActive(ctx, userID, isActive)
 	if err != nil {
@@ -88,55 +127,244 @@ func (s *prService) SetUserActive(ctx context.Context, userID string, isActive b
 func (s *prService) CreatePR(ctx context.Context, pr models.PullRequest) (*models.PullRequest, error) {
 	if pr.ID == "" || pr.Name == "" || pr.AuthorID == "" {
 		s.logger.Warn("invalid pr data")
-		return nil, errors.New("pr fields required")
+		return nil, invalidArgument("pr fields required")
+	}
+
+	if pr.LinesAdded < 0 || pr.LinesRemoved < 0 {
+		return nil, invalidArgument("line counts must not be negative")
+	}
+
+	if pr.Repository == "" {
+		pr.Repository = models.DefaultRepository
+	}
+	if _, err := s.repo.GetRepository(ctx, pr.Repository); err != nil {
+		return nil, fmt.Errorf("get repository: %w", err)
+	}
+	if _, err := s.repo.GetUserTeam(ctx, pr.AuthorID); err != nil {
+		return nil, fmt.Errorf("get author team: %w", err)
+	}
+	pr.ID = models.PRKey(pr.Repository, pr.ID)
+
+	pr.RequiredTags = normalizeTags(pr.RequiredTags)
+	pr.Labels = normalizeTags(pr.Labels)
+	var (
+		sel          selection
+		explanations []models.ReviewerExplanation
+	)
+	if !pr.IsDraft {
+		var err error
+		if sel, explanations, err = s.chooseReviewers(ctx, &pr); err != nil {
+			return nil, err
+		}
+	}
+	pr.AssignedReviewers = sel.reviewers
+	pr.Status = "OPEN"
+
+	if _, err := s.repo.GetPR(ctx, pr.ID); err == nil {
+		return nil, ErrPRExists
+	}
+
+	var created *models.PullRequest
+	err := s.inTx(ctx, func(tx *prService) error {
+		if err := tx.repo.CreatePR(ctx, pr); err != nil {
+			return fmt.Errorf("create pr: %w", err)
+		}
+		if err := tx.saveExplanations(ctx, pr.ID, explanations); err != nil {
+			return err
+		}
+		var err error
+		created, err = tx.repo.GetPR(ctx, pr.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if pr.IsDraft {
+		s.logger.Info("draft pr created", "pr", pr.ID)
+		return created, nil
+	}
+	s.finishSelection(ctx, created, sel, explanations)
+	return created, nil
+}
+
+// ReadyPR takes a draft pull request out of draft and selects its reviewers
+// among the people available now. Marking a pull request that is not a draft
+// returns it unchanged.
+func (s *prService) ReadyPR(ctx context.Context, prID string) (*models.PullRequest, error) {
+	if prID == "" {
+		s.logger.Warn("invalid pr id")
+		return nil, invalidArgument("pr id required")
+	}
+	pr, err := s.repo.GetPR(ctx, prID)
+	if err != nil {
+		s.logger.Error("get pr failed", "err", err)
+		return nil, fmt.Errorf("get pr: %w", err)
+	}
+	if pr.Status == "MERGED" {
+		return nil, ErrPRMerged
+	}
+	if !pr.IsDraft {
+		return s.GetPR(ctx, prID)
+	}
+
+	sel, explanations, err := s.chooseReviewers(ctx, pr)
+	if err != nil {
+		return nil, err
+	}
+	var ready *models.PullRequest
+	err = s.inTx(ctx, func(tx *prService) error {
+		var err error
+		if ready, err = tx.repo.MarkPRReady(ctx, prID, sel.reviewers); err != nil {
+			return err
+		}
+		return tx.saveExplanations(ctx, prID, explanations)
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrPRNotDraft) {
+			// Marked ready or merged meanwhile; report the current state.
+			return s.ReadyPR(ctx, prID)
+		}
+		s.logger.Error("mark pr ready failed", "err", err)
+		return nil, fmt.Errorf("mark pr ready: %w", err)
 	}
+	ready.RequiredTags = pr.RequiredTags
+	s.finishSelection(ctx, ready, sel, explanations)
+	return ready, nil
+}
 
+// reviewTeam returns the team reviewing pr: the team owning its repository
+// or, if the repository has none, the author's team.
+func (s *prService) reviewTeam(ctx context.Context, pr *models.PullRequest) (string, error) {
+	repo, err := s.repo.GetRepository(ctx, pr.Repository)
+	if err != nil {
+		return "", fmt.Errorf("get repository: %w", err)
+	}
+	if repo.TeamName != "" {
+		return repo.TeamName, nil
+	}
 	teamName, err := s.repo.GetUserTeam(ctx, pr.AuthorID)
 	if err != nil {
-		return nil, fmt.Errorf("get author team: %w", err)
+		return "", fmt.Errorf("get author team: %w", err)
+	}
+	return teamName, nil
+}
+
+// chooseReviewers selects reviewers for pr among the active members of the
+// reviewing team, honouring pair rules, code owners, required tags, working
+// hours and mentorship, and explains the choice.
+func (s *prService) chooseReviewers(ctx context.Context, pr *models.PullRequest) (selection, []models.ReviewerExplanation, error) {
+	teamName, err := s.reviewTeam(ctx, pr)
+	if err != nil {
+		return selection{}, nil, err
 	}
 
 	members, err := s.repo.GetActiveMembersExcluding(ctx, teamName, pr.AuthorID)
 	if err != nil {
-		return nil, fmt.Errorf("get active members: %w", err)
+		return selection{}, nil, fmt.Errorf("get active members: %w", err)
 	}
 
-	rand.Shuffle(len(members), func(i, j int) { members[i], members[j] = members[j], members[i] })
-	if len(members) > 2 {
-		pr.AssignedReviewers = members[:2]
-	} else {
-		pr.AssignedReviewers = members
+	rules, err := s.pairConstraints(ctx, teamName, pr.AuthorID)
+	if err != nil {
+		return selection{}, nil, err
+	}
+	reasons := map[string]string{pr.AuthorID: models.ExclusionAuthor}
+	members = rules.filter(members, reasons)
+	for _, id := range rules.always {
+		if !slices.Contains(members, id) {
+			return selection{}, nil, &RulesUnsatisfiableError{
+				Msg: fmt.Sprintf("%s must review pull requests by %s but is not an active team member", id, pr.AuthorID),
+			}
+		}
 	}
-	pr.Status = "OPEN"
 
-	if _, err := s.repo.GetPR(ctx, pr.ID); err == nil {
-		return nil, errors.New("PR_EXISTS")
+	owners, err := s.pathOwners(ctx, teamName, pr.ChangedFiles)
+	if err != nil {
+		return selection{}, nil, fmt.Errorf("get path owners: %w", err)
+	}
+
+	var candidateTags map[string][]string
+	if len(pr.RequiredTags) > 0 && len(members) > 0 {
+		candidateTags, err = s.repo.GetUsersTags(ctx, members)
+		if err != nil {
+			return selection{}, nil, fmt.Errorf("get candidate tags: %w", err)
+		}
+	}
+
+	inHours, err := s.workingNow(ctx, members)
+	if err != nil {
+		return selection{}, nil, fmt.Errorf("get working hours: %w", err)
+	}
+
+	team, err := s.repo.GetTeam(ctx, teamName)
+	if err != nil {
+		return selection{}, nil, fmt.Errorf("get team: %w", err)
+	}
+
+	seniority, err := s.teamSeniority(ctx, team, members)
+	if err != nil {
+		return selection{}, nil, err
 	}
 
-	if err := s.repo.CreatePR(ctx, pr); err != nil {
-		return nil, fmt.Errorf("create pr: %w", err)
+	in := selectionInput{
+		candidates:   members,
+		owners:       owners,
+		candidateTag: candidateTags,
+		requiredTags: pr.RequiredTags,
+		required:     rules.always,
+		inHours:      inHours,
+		mentorship:   team.Mentorship,
+		seniority:    seniority,
+	}
+	if team.LargePRLines > 0 && pr.LinesAdded+pr.LinesRemoved >= team.LargePRLines {
+		in.limit = largePRReviewers
+	}
+	rng, seed := s.newRand()
+	sel := selectReviewers(rng, in)
+	s.logger.Info("reviewers selected", "pr", pr.ID, "seed", seed, "candidates", members,
+		"owners", owners, "required_tags", pr.RequiredTags, "in_hours", workingIDs(inHours), "limit", in.limit,
+		"reviewers", sel.reviewers)
+	if len(sel.violations) > 0 {
+		s.logger.Warn("review policy violated", "pr", pr.ID, "team", teamName, "violations", sel.violations)
 	}
+	excluded := exclusions(team, members, reasons)
+	return sel, s.explainSelection(in, sel, seed, excluded), nil
+}
 
-	return s.repo.GetPR(ctx, pr.ID)
+// finishSelection fills the selection details into a pull request that has
+// just got its reviewers and notifies the reviewers.
+func (s *prService) finishSelection(ctx context.Context, pr *models.PullRequest, sel selection, explanations []models.ReviewerExplanation) {
+	pr.ReviewerTags = sel.matchedTags
+	pr.UncoveredTags = sel.uncoveredTags
+	pr.PolicyViolations = sel.violations
+	pr.Explanations = explanations
+	s.publish(ctx, reviewEvents(models.EventReviewAssigned, pr, pr.AssignedReviewers...)...)
 }
 
 func (s *prService) MergePR(ctx context.Context, prID string) (*models.PullRequest, error) {
 	if prID == "" {
 		s.logger.Warn("invalid pr id")
-		return nil, errors.New("pr id required")
+		return nil, invalidArgument("pr id required")
+	}
+	current, err := s.repo.GetPR(ctx, prID)
+	if err != nil {
+		s.logger.Error("get pr failed", "err", err)
+		return nil, fmt.Errorf("get pr: %w", err)
 	}
 	pr, err := s.repo.MergePR(ctx, prID)
 	if err != nil {
 		s.logger.Error("merge pr failed", "err", err)
 		return nil, fmt.Errorf("merge pr: %w", err)
 	}
+	if current.Status != "MERGED" {
+		s.publish(ctx, reviewEvents(models.EventPRMerged, pr, pr.AssignedReviewers...)...)
+	}
 	return pr, nil
 }
 
 func (s *prService) ReassignReviewer(ctx context.Context, prID, oldUserID string) (*models.PullRequest, string, error) {
 	if prID == "" || oldUserID == "" {
 		s.logger.Warn("invalid reassign data")
-		return nil, "", errors.New("fields required")
+		return nil, "", invalidArgument("fields required")
 	}
 
 	pr, err := s.repo.GetPR(ctx, prID)
@@ -146,7 +374,7 @@ func (s *prService) ReassignReviewer(ctx context.Context, prID, oldUserID string
 	}
 
 	if pr.Status == "MERGED" {
-		return nil, "", errors.New("PR_MERGED")
+		return nil, "", ErrPRMerged
 	}
 
 	found := false
@@ -157,45 +385,126 @@ func (s *prService) ReassignReviewer(ctx context.Context, prID, oldUserID string
 		}
 	}
 	if !found {
-		return nil, "", errors.New("NOT_ASSIGNED")
+		return nil, "", ErrNotAssigned
 	}
 
-	teamName, err := s.repo.GetUserTeam(ctx, oldUserID)
+	// The replacement comes from the team reviewing the pull request, which
+	// may be the repository's owning team rather than the old reviewer's.
+	teamName, err := s.reviewTeam(ctx, pr)
 	if err != nil {
-		s.logger.Error("get old user team failed", "err", err)
-		return nil, "", fmt.Errorf("get old team: %w", err)
+		s.logger.Error("get review team failed", "err", err)
+		return nil, "", err
 	}
 
-	newUserID, err := s.repo.RandomActiveMemberFromTeam(ctx, teamName, oldUserID)
+	rules, err := s.pairConstraints(ctx, teamName, pr.AuthorID)
+	if err != nil {
+		s.logger.Error("get pair rules failed", "err", err)
+		return nil, "", err
+	}
+	if rules.requires(oldUserID) {
+		return nil, "", &RulesUnsatisfiableError{Msg: fmt.Sprintf("%s must review pull requests by %s", oldUserID, pr.AuthorID)}
+	}
+
+	members, err := s.repo.GetActiveMembersExcluding(ctx, teamName, oldUserID)
+	if err != nil {
+		s.logger.Error("get candidates failed", "err", err)
+		return nil, "", fmt.Errorf("get candidates: %w", err)
+	}
+	reasons := map[string]string{oldUserID: models.ExclusionReplaced, pr.AuthorID: models.ExclusionAuthor}
+	members = rules.filter(members, reasons)
+	assigned := make(map[string]bool, len(pr.AssignedReviewers))
+	for _, r := range pr.AssignedReviewers {
+		assigned[r] = true
+	}
+	var candidates []string
+	for _, m := range members {
+		switch {
+		case m == pr.AuthorID:
+		case assigned[m]:
+			reasons[m] = models.ExclusionAssigned
+		default:
+			candidates = append(candidates, m)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, "", fmt.Errorf("get new reviewer: %w", repository.ErrNoCandidate)
+	}
+	team, err := s.repo.GetTeam(ctx, teamName)
+	if err != nil {
+		s.logger.Error("get team failed", "err", err)
+		return nil, "", fmt.Errorf("get team: %w", err)
+	}
+	pool, seniorOnly, violations, err := s.seniorReplacement(ctx, team, pr, oldUserID, candidates)
+	if err != nil {
+		s.logger.Error("get seniority failed", "err", err)
+		return nil, "", err
+	}
+	newUserID, explanation, err := s.pickReviewer(ctx, prID, pool)
 	if err != nil {
 		s.logger.Error("get new reviewer failed", "err", err)
 		return nil, "", fmt.Errorf("get new reviewer: %w", err)
 	}
+	if seniorOnly {
+		explanation.Strategy = models.StrategySenior
+	}
+	explanation.Excluded = exclusions(team, candidates, reasons)
 
-	pr, err = s.repo.ReassignReviewer(ctx, prID, oldUserID, newUserID)
+	err = s.inTx(ctx, func(tx *prService) error {
+		var err error
+		if pr, err = tx.repo.ReassignReviewer(ctx, prID, oldUserID, newUserID); err != nil {
+			s.logger.Error("reassign failed", "err", err)
+			return fmt.Errorf("reassign: %w", err)
+		}
+		return tx.saveExplanations(ctx, prID, []models.ReviewerExplanation{explanation})
+	})
 	if err != nil {
-		s.logger.Error("reassign failed", "err", err)
-		return nil, "", fmt.Errorf("reassign: %w", err)
+		return nil, "", err
 	}
+	if err := s.attachExplanations(ctx, pr); err != nil {
+		s.logger.Error("get explanations failed", "err", err)
+	}
+	pr.PolicyViolations = violations
+	s.publish(ctx, append(
+		reviewEvents(models.EventReviewUnassigned, pr, oldUserID),
+		reviewEvents(models.EventReviewAssigned, pr, newUserID)...)...)
 	return pr, newUserID, nil
 }
 
-func (s *prService) GetUserReviews(ctx context.Context, userID string) ([]models.PRShort, error) {
+func (s *prService) GetUserReviews(ctx context.Context, userID string, filter models.ReviewFilter) ([]models.PRShort, *models.Cursor, error) {
 	if userID == "" {
 		s.logger.Warn("invalid user id")
-		return nil, errors.New("user id required")
+		return nil, nil, invalidArgument("user id required")
+	}
+	if filter.Status != "" && filter.Status != "OPEN" && filter.Status != "MERGED" {
+		return nil, nil, fmt.Errorf("%w: unknown status %q", ErrInvalidFilter, filter.Status)
+	}
+	if filter.Limit < 0 || filter.Limit > maxPageSize {
+		return nil, nil, fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidFilter, maxPageSize)
+	}
+	if filter.Limit == 0 {
+		filter.Limit = defaultPageSize
 	}
+	filter.Label = strings.ToLower(strings.TrimSpace(filter.Label))
 
 	_, err := s.repo.GetUserTeam(ctx, userID)
-	if err != nil {
+	if err != nil && !errors.Is(err, repository.ErrUserNoTeam) {
 		s.logger.Error("user not found", "err", err)
-		return nil, fmt.Errorf("check user: %w", err)
+		return nil, nil, fmt.Errorf("check user: %w", err)
 	}
 
-	prs, err := s.repo.GetUserReviewPRs(ctx, userID)
+	limit := filter.Limit
+	filter.Limit++
+	prs, err := s.repo.GetUserReviewPRs(ctx, userID, filter)
 	if err != nil {
 		s.logger.Error("get reviews failed", "err", err)
-		return nil, fmt.Errorf("get reviews: %w", err)
+		return nil, nil, fmt.Errorf("get reviews: %w", err)
+	}
+
+	var next *models.Cursor
+	if len(prs) > limit {
+		prs = prs[:limit]
+		last := prs[limit-1]
+		next = &models.Cursor{CreatedAt: *last.CreatedAt, ID: last.ID}
 	}
-	return prs, nil
+	return prs, next, nil
 }
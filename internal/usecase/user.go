@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AlexOFF1/avito-backend-trainee-task-autumn/internal/models"
+)
+
+func (s *prService) GetUser(ctx context.Context, userID string) (*models.UserDetails, error) {
+	if userID == "" {
+		s.logger.Warn("invalid user id")
+		return nil, invalidArgument("user id required")
+	}
+	user, err := s.repo.GetUserDetails(ctx, userID)
+	if err != nil {
+		s.logger.Error("get user failed", "err", err)
+		return nil, fmt.Errorf("get user: %w", err)
+	}
+	schedules, err := s.repo.GetUserSchedules(ctx, []string{userID})
+	if err != nil {
+		s.logger.Error("get schedule failed", "err", err)
+		return nil, fmt.Errorf("get schedule: %w", err)
+	}
+	user.Schedule = schedules[userID]
+	user.InWorkingHours = user.Schedule.Contains(s.clock.Now())
+	return user, nil
+}
+
+// ListUsers returns a page of users ordered by id and, when more users
+// follow, the id to continue after.
+func (s *prService) ListUsers(ctx context.Context, filter models.UserFilter) ([]models.User, string, error) {
+	if filter.Limit < 0 || filter.Limit > maxPageSize {
+		return nil, "", fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidFilter, maxPageSize)
+	}
+	if filter.Limit == 0 {
+		filter.Limit = defaultPageSize
+	}
+
+	limit := filter.Limit
+	filter.Limit++
+	users, err := s.repo.ListUsers(ctx, filter)
+	if err != nil {
+		s.logger.Error("list users failed", "err", err)
+		return nil, "", fmt.Errorf("list users: %w", err)
+	}
+
+	var next string
+	if len(users) > limit {
+		users = users[:limit]
+		next = users[limit-1].ID
+	}
+	return users, next, nil
+}
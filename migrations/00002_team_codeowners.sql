@@ -0,0 +1,13 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+CREATE TABLE IF NOT EXISTS team_codeowners (
+    team_name  TEXT PRIMARY KEY REFERENCES teams(team_name) ON DELETE CASCADE,
+    content    TEXT NOT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+
+DROP TABLE IF EXISTS team_codeowners;
@@ -0,0 +1,20 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+-- Users removed from a team are detached (team_name = NULL) instead of being
+-- deleted, so pull requests they authored or reviewed keep a valid reference.
+ALTER TABLE users ALTER COLUMN team_name DROP NOT NULL;
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_team_name_fkey;
+ALTER TABLE users ADD CONSTRAINT users_team_name_fkey
+    FOREIGN KEY (team_name) REFERENCES teams(team_name) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_pr_open_status ON pull_requests(status) WHERE status = 'OPEN';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+
+-- team_name stays nullable: detached users may still be referenced by PRs.
+DROP INDEX IF EXISTS idx_pr_open_status;
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_team_name_fkey;
+ALTER TABLE users ADD CONSTRAINT users_team_name_fkey
+    FOREIGN KEY (team_name) REFERENCES teams(team_name) ON DELETE CASCADE;
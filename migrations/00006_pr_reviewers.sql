@@ -0,0 +1,49 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+-- pr_reviewers is a denormalized copy of pull_requests.assigned_reviewers kept
+-- in sync by a trigger. It lets review queues be read with keyset pagination
+-- over a btree index instead of scanning every PR a user has ever reviewed.
+CREATE TABLE IF NOT EXISTS pr_reviewers (
+    user_id         TEXT NOT NULL,
+    pull_request_id TEXT NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    author_id       TEXT NOT NULL,
+    status          TEXT NOT NULL,
+    created_at      TIMESTAMP NOT NULL,
+    PRIMARY KEY (user_id, pull_request_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_pr_reviewers_user_created
+    ON pr_reviewers(user_id, created_at, pull_request_id);
+CREATE INDEX IF NOT EXISTS idx_pr_reviewers_user_status_created
+    ON pr_reviewers(user_id, status, created_at, pull_request_id);
+CREATE INDEX IF NOT EXISTS idx_pr_reviewers_pr ON pr_reviewers(pull_request_id);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION sync_pr_reviewers() RETURNS trigger AS $$
+BEGIN
+    DELETE FROM pr_reviewers WHERE pull_request_id = NEW.pull_request_id;
+    INSERT INTO pr_reviewers (user_id, pull_request_id, author_id, status, created_at)
+    SELECT DISTINCT r, NEW.pull_request_id, NEW.author_id, NEW.status, NEW.created_at
+    FROM unnest(NEW.assigned_reviewers) AS r;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+DROP TRIGGER IF EXISTS trg_sync_pr_reviewers ON pull_requests;
+CREATE TRIGGER trg_sync_pr_reviewers
+    AFTER INSERT OR UPDATE OF assigned_reviewers, status, author_id, created_at ON pull_requests
+    FOR EACH ROW EXECUTE FUNCTION sync_pr_reviewers();
+
+INSERT INTO pr_reviewers (user_id, pull_request_id, author_id, status, created_at)
+SELECT DISTINCT r, p.pull_request_id, p.author_id, p.status, p.created_at
+FROM pull_requests p, unnest(p.assigned_reviewers) AS r
+ON CONFLICT DO NOTHING;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+
+DROP TRIGGER IF EXISTS trg_sync_pr_reviewers ON pull_requests;
+DROP FUNCTION IF EXISTS sync_pr_reviewers();
+DROP TABLE IF EXISTS pr_reviewers;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+CREATE INDEX IF NOT EXISTS idx_pr_created ON pull_requests(created_at, pull_request_id);
+CREATE INDEX IF NOT EXISTS idx_pr_status_created ON pull_requests(status, created_at, pull_request_id);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+
+DROP INDEX IF EXISTS idx_pr_status_created;
+DROP INDEX IF EXISTS idx_pr_created;
@@ -0,0 +1,9 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+CREATE INDEX IF NOT EXISTS idx_users_username_lower ON users(lower(username) text_pattern_ops);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+
+DROP INDEX IF EXISTS idx_users_username_lower;
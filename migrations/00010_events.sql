@@ -0,0 +1,23 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+-- events is an append-only log of assignment changes. Stream clients resume
+-- from it by id after a reconnect.
+CREATE TABLE IF NOT EXISTS events (
+    id              BIGSERIAL PRIMARY KEY,
+    type            TEXT NOT NULL,
+    user_id         TEXT NOT NULL,
+    pull_request_id TEXT NOT NULL,
+    author_id       TEXT NOT NULL,
+    team_name       TEXT,
+    created_at      TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_events_user ON events(user_id, id);
+CREATE INDEX IF NOT EXISTS idx_events_team ON events(team_name, id);
+CREATE INDEX IF NOT EXISTS idx_events_created ON events(created_at);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+
+DROP TABLE IF EXISTS events;
@@ -0,0 +1,76 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+-- team_settings holds per-team review SLA. Teams without a row use the
+-- service default; reassign_after_seconds NULL disables auto-reassignment.
+CREATE TABLE IF NOT EXISTS team_settings (
+    team_name              TEXT PRIMARY KEY REFERENCES teams(team_name) ON DELETE CASCADE,
+    review_sla_seconds     INT NOT NULL CHECK (review_sla_seconds > 0),
+    reassign_after_seconds INT CHECK (reassign_after_seconds > review_sla_seconds),
+    updated_at             TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- review_assignments is the history of reviewer assignments, one row per
+-- period a user was assigned to a pull request. It is maintained by a trigger
+-- on pull_requests, so every code path that changes reviewers is covered.
+CREATE TABLE IF NOT EXISTS review_assignments (
+    id              BIGSERIAL PRIMARY KEY,
+    pull_request_id TEXT NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    user_id         TEXT NOT NULL,
+    assigned_at     TIMESTAMP NOT NULL DEFAULT NOW(),
+    ended_at        TIMESTAMP,
+    end_reason      TEXT CHECK (end_reason IN ('unassigned', 'merged')),
+    reminded_at     TIMESTAMP,
+    escalated_at    TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_review_assignments_open
+    ON review_assignments(pull_request_id, user_id) WHERE ended_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_review_assignments_open_assigned
+    ON review_assignments(assigned_at) WHERE ended_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_review_assignments_user
+    ON review_assignments(user_id, assigned_at);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION track_review_assignments() RETURNS trigger AS $$
+BEGIN
+    IF NEW.status = 'MERGED' THEN
+        UPDATE review_assignments
+        SET ended_at = COALESCE(NEW.merged_at, NOW()), end_reason = 'merged'
+        WHERE pull_request_id = NEW.pull_request_id AND ended_at IS NULL;
+        RETURN NULL;
+    END IF;
+
+    UPDATE review_assignments
+    SET ended_at = NOW(), end_reason = 'unassigned'
+    WHERE pull_request_id = NEW.pull_request_id AND ended_at IS NULL
+      AND NOT (user_id = ANY(NEW.assigned_reviewers));
+
+    INSERT INTO review_assignments (pull_request_id, user_id, assigned_at)
+    SELECT DISTINCT NEW.pull_request_id, r, NOW()
+    FROM unnest(NEW.assigned_reviewers) AS r
+    ON CONFLICT (pull_request_id, user_id) WHERE ended_at IS NULL DO NOTHING;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+DROP TRIGGER IF EXISTS trg_track_review_assignments ON pull_requests;
+CREATE TRIGGER trg_track_review_assignments
+    AFTER INSERT OR UPDATE OF assigned_reviewers, status ON pull_requests
+    FOR EACH ROW EXECUTE FUNCTION track_review_assignments();
+
+-- Existing reviews count as assigned when their pull request was created.
+INSERT INTO review_assignments (pull_request_id, user_id, assigned_at, ended_at, end_reason)
+SELECT DISTINCT p.pull_request_id, r, COALESCE(p.created_at, NOW()),
+       CASE WHEN p.status = 'MERGED' THEN COALESCE(p.merged_at, NOW()) END,
+       CASE WHEN p.status = 'MERGED' THEN 'merged' END
+FROM pull_requests p, unnest(p.assigned_reviewers) AS r;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+
+DROP TRIGGER IF EXISTS trg_track_review_assignments ON pull_requests;
+DROP FUNCTION IF EXISTS track_review_assignments();
+DROP TABLE IF EXISTS review_assignments;
+DROP TABLE IF EXISTS team_settings;
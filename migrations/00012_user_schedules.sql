@@ -0,0 +1,19 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+-- user_schedules holds working hours in the user's IANA timezone. Start and
+-- end are minutes after local midnight; work_days is a bitmask with bit 0 for
+-- Sunday. Users without a row are treated as always available.
+CREATE TABLE IF NOT EXISTS user_schedules (
+    user_id    TEXT PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+    timezone   TEXT NOT NULL,
+    work_start SMALLINT NOT NULL CHECK (work_start >= 0 AND work_start < 1440),
+    work_end   SMALLINT NOT NULL CHECK (work_end > work_start AND work_end <= 1440),
+    work_days  SMALLINT NOT NULL CHECK (work_days > 0 AND work_days < 128),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+
+DROP TABLE IF EXISTS user_schedules;
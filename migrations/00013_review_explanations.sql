@@ -0,0 +1,24 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+-- review_explanations records why each reviewer was picked for a pull
+-- request. A reviewer picked again for the same pull request overwrites the
+-- earlier explanation.
+CREATE TABLE IF NOT EXISTS review_explanations (
+    pull_request_id  TEXT NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    user_id          TEXT NOT NULL,
+    strategy         TEXT NOT NULL,
+    pool_size        INT NOT NULL,
+    excluded         JSONB NOT NULL DEFAULT '[]',
+    matched_tags     TEXT[] NOT NULL DEFAULT '{}',
+    in_working_hours BOOLEAN NOT NULL,
+    score            INT NOT NULL,
+    seed             BIGINT NOT NULL,
+    created_at       TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (pull_request_id, user_id)
+);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+
+DROP TABLE IF EXISTS review_explanations;
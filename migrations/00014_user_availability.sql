@@ -0,0 +1,63 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+-- user_availability records the periods a user was active in a team, so
+-- fairness can be judged against the time someone could actually be picked.
+-- It is maintained by a trigger on users.
+CREATE TABLE IF NOT EXISTS user_availability (
+    id         BIGSERIAL PRIMARY KEY,
+    user_id    TEXT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    team_name  TEXT NOT NULL,
+    started_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    ended_at   TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_user_availability_open
+    ON user_availability(user_id) WHERE ended_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_user_availability_team
+    ON user_availability(team_name, started_at);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION track_user_availability() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'UPDATE'
+       AND NEW.is_active IS NOT DISTINCT FROM OLD.is_active
+       AND NEW.team_name IS NOT DISTINCT FROM OLD.team_name THEN
+        RETURN NULL;
+    END IF;
+
+    UPDATE user_availability SET ended_at = NOW()
+    WHERE user_id = NEW.user_id AND ended_at IS NULL;
+
+    IF NEW.is_active AND NEW.team_name IS NOT NULL THEN
+        INSERT INTO user_availability (user_id, team_name) VALUES (NEW.user_id, NEW.team_name);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+DROP TRIGGER IF EXISTS trg_track_user_availability ON users;
+CREATE TRIGGER trg_track_user_availability
+    AFTER INSERT OR UPDATE OF is_active, team_name ON users
+    FOR EACH ROW EXECUTE FUNCTION track_user_availability();
+
+-- Earlier periods were not recorded: active members are taken to have been
+-- in their team since they last moved into it, or else since the first pull
+-- request.
+INSERT INTO user_availability (user_id, team_name, started_at)
+SELECT u.user_id, u.team_name,
+       COALESCE(
+           (SELECT MAX(h.moved_at) FROM user_team_history h
+            WHERE h.user_id = u.user_id AND h.to_team = u.team_name),
+           (SELECT MIN(p.created_at) FROM pull_requests p),
+           NOW())
+FROM users u
+WHERE u.is_active AND u.team_name IS NOT NULL;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+
+DROP TRIGGER IF EXISTS trg_track_user_availability ON users;
+DROP FUNCTION IF EXISTS track_user_availability();
+DROP TABLE IF EXISTS user_availability;
@@ -0,0 +1,22 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+-- team_pair_rules constrain who reviews whom within a team: 'never' keeps
+-- reviewer_id off pull requests by author_id, 'always' puts them on every one.
+CREATE TABLE IF NOT EXISTS team_pair_rules (
+    id          BIGSERIAL PRIMARY KEY,
+    team_name   TEXT NOT NULL REFERENCES teams(team_name) ON DELETE CASCADE,
+    kind        TEXT NOT NULL CHECK (kind IN ('never', 'always')),
+    reviewer_id TEXT NOT NULL,
+    author_id   TEXT NOT NULL,
+    created_at  TIMESTAMP NOT NULL DEFAULT NOW(),
+    CHECK (reviewer_id <> author_id),
+    UNIQUE (team_name, reviewer_id, author_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_team_pair_rules_author ON team_pair_rules(author_id);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+
+DROP TABLE IF EXISTS team_pair_rules;
@@ -0,0 +1,15 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS seniority TEXT NOT NULL DEFAULT 'middle'
+    CHECK (seniority IN ('junior', 'middle', 'senior'));
+
+-- With mentorship on, every pull request in the team gets a senior reviewer
+-- and, when there is one, a junior as the second reviewer.
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS mentorship BOOLEAN NOT NULL DEFAULT false;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+
+ALTER TABLE teams DROP COLUMN IF EXISTS mentorship;
+ALTER TABLE users DROP COLUMN IF EXISTS seniority;
@@ -0,0 +1,15 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+-- Draft pull requests have no reviewers until they are marked ready. Changed
+-- files and required tags are kept so selection can run at that point.
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS is_draft BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS changed_files TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS required_tags TEXT[] NOT NULL DEFAULT '{}';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS required_tags;
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS changed_files;
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS is_draft;
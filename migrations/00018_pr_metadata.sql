@@ -0,0 +1,29 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS repository TEXT NOT NULL DEFAULT '';
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS url TEXT NOT NULL DEFAULT '';
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS labels TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS lines_added INT NOT NULL DEFAULT 0 CHECK (lines_added >= 0);
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS lines_removed INT NOT NULL DEFAULT 0 CHECK (lines_removed >= 0);
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS description TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS idx_pr_repository ON pull_requests(repository);
+CREATE INDEX IF NOT EXISTS idx_pr_labels ON pull_requests USING GIN(labels);
+
+-- Pull requests changing at least large_pr_lines lines get an extra reviewer;
+-- NULL turns this off.
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS large_pr_lines INT CHECK (large_pr_lines > 0);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+
+ALTER TABLE teams DROP COLUMN IF EXISTS large_pr_lines;
+DROP INDEX IF EXISTS idx_pr_labels;
+DROP INDEX IF EXISTS idx_pr_repository;
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS description;
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS lines_removed;
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS lines_added;
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS labels;
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS url;
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS repository;
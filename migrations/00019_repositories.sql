@@ -0,0 +1,36 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+-- repositories map repos to the team that reviews their pull requests. A repo
+-- without a team is reviewed by the author's team.
+CREATE TABLE IF NOT EXISTS repositories (
+    name       TEXT PRIMARY KEY,
+    team_name  TEXT REFERENCES teams(team_name) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_repositories_team ON repositories(team_name);
+
+INSERT INTO repositories (name) VALUES ('default') ON CONFLICT DO NOTHING;
+
+-- Repositories already named on pull requests are kept, reviewed by the
+-- author's team until mapped. Pull requests without one move to the default
+-- repository. Ids stored so far stay as they are; only new pull requests
+-- outside the default repository are keyed as <repository>#<id>.
+INSERT INTO repositories (name)
+SELECT DISTINCT repository FROM pull_requests WHERE repository <> ''
+ON CONFLICT DO NOTHING;
+UPDATE pull_requests SET repository = 'default' WHERE repository = '';
+ALTER TABLE pull_requests ALTER COLUMN repository SET DEFAULT 'default';
+ALTER TABLE pull_requests ADD CONSTRAINT fk_pull_requests_repository
+    FOREIGN KEY (repository) REFERENCES repositories(name);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+
+ALTER TABLE pull_requests DROP CONSTRAINT IF EXISTS fk_pull_requests_repository;
+ALTER TABLE pull_requests ALTER COLUMN repository SET DEFAULT '';
+-- Only the default repository stood for "no repository"; other names are the
+-- values the pull requests were created with.
+UPDATE pull_requests SET repository = '' WHERE repository = 'default';
+DROP TABLE IF EXISTS repositories;
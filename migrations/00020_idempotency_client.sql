@@ -0,0 +1,17 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+-- Keys are scoped per client so one caller cannot replay or block another's
+-- request by guessing its key.
+ALTER TABLE idempotency_keys ADD COLUMN IF NOT EXISTS client TEXT NOT NULL DEFAULT '';
+ALTER TABLE idempotency_keys DROP CONSTRAINT IF EXISTS idempotency_keys_pkey;
+ALTER TABLE idempotency_keys ADD PRIMARY KEY (client, idempotency_key, route);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+
+DELETE FROM idempotency_keys a USING idempotency_keys b
+WHERE a.idempotency_key = b.idempotency_key AND a.route = b.route AND a.client > b.client;
+ALTER TABLE idempotency_keys DROP CONSTRAINT IF EXISTS idempotency_keys_pkey;
+ALTER TABLE idempotency_keys ADD PRIMARY KEY (idempotency_key, route);
+ALTER TABLE idempotency_keys DROP COLUMN IF EXISTS client;
@@ -0,0 +1,20 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+-- review_team is the team reviewing the pull request: the repository owner
+-- or, without one, the author's team. team_name stays the author's team; a
+-- team stream follows events where it is either.
+ALTER TABLE events ADD COLUMN IF NOT EXISTS review_team TEXT;
+UPDATE events e SET review_team = COALESCE(r.team_name, e.team_name)
+FROM pull_requests p
+LEFT JOIN repositories r ON r.name = p.repository
+WHERE p.pull_request_id = e.pull_request_id;
+UPDATE events SET review_team = team_name WHERE review_team IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_events_review_team ON events(review_team, id);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+
+DROP INDEX IF EXISTS idx_events_review_team;
+ALTER TABLE events DROP COLUMN IF EXISTS review_team;
@@ -0,0 +1,25 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+-- Review SLA times are compared against instants from the service clock, so
+-- they are stored with a time zone. Values written by NOW() were wall times
+-- in the server's zone; reminded_at and escalated_at came from the service
+-- in UTC.
+ALTER TABLE review_assignments
+    ALTER COLUMN assigned_at TYPE TIMESTAMPTZ USING assigned_at AT TIME ZONE current_setting('TimeZone'),
+    ALTER COLUMN ended_at TYPE TIMESTAMPTZ USING ended_at AT TIME ZONE current_setting('TimeZone'),
+    ALTER COLUMN reminded_at TYPE TIMESTAMPTZ USING reminded_at AT TIME ZONE 'UTC',
+    ALTER COLUMN escalated_at TYPE TIMESTAMPTZ USING escalated_at AT TIME ZONE 'UTC';
+ALTER TABLE team_settings
+    ALTER COLUMN updated_at TYPE TIMESTAMPTZ USING updated_at AT TIME ZONE current_setting('TimeZone');
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+
+ALTER TABLE team_settings
+    ALTER COLUMN updated_at TYPE TIMESTAMP USING updated_at AT TIME ZONE current_setting('TimeZone');
+ALTER TABLE review_assignments
+    ALTER COLUMN assigned_at TYPE TIMESTAMP USING assigned_at AT TIME ZONE current_setting('TimeZone'),
+    ALTER COLUMN ended_at TYPE TIMESTAMP USING ended_at AT TIME ZONE current_setting('TimeZone'),
+    ALTER COLUMN reminded_at TYPE TIMESTAMP USING reminded_at AT TIME ZONE 'UTC',
+    ALTER COLUMN escalated_at TYPE TIMESTAMP USING escalated_at AT TIME ZONE 'UTC';